@@ -0,0 +1,212 @@
+package cidoc
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/verisart/xsd/lido"
+)
+
+func testRecord() *lido.Lido {
+	l := &lido.Lido{}
+	l.AppendRecID("test", lido.LocalRecordType, "rec 1")
+	desc := l.CreateDesc("it")
+	desc.AppendAATWorkType(lido.URIType, "http://vocab.getty.edu/aat/300033618", "dipinto")
+	desc.AddTitle("Primavera", "", true, lido.RepositoryTitle)
+	repository := desc.AddRepository("Galleria degli Uffizi", "Inv. 8360", nil)
+	repository.RepositoryName.AppendID("VIAF", lido.URIType, "http://viaf.org/viaf/155675539")
+	desc.AddMeasurement("height", "cm", "207")
+	desc.AddMeasurement("width", "cm", "319")
+
+	production := desc.AddEvent(lido.NewURIConcept(string(E12Production), "Production", "en"))
+	botticelli := lido.NewActor("Botticelli, Sandro", "")
+	botticelli.AppendID("ULAN", lido.URIType, "http://vocab.getty.edu/ulan/500010368")
+	production.AddActor(botticelli, nil)
+	production.AddPlace(lido.NewPlace("Firenze", ""))
+	production.SetDisplayDate("um 1480", "de")
+	production.Date.Date = lido.NewDateSpan("1478", "1482-12-31")
+	production.AddMaterialsTech(&lido.Concept{Terms: []*lido.Term{{Value: "tempera"}}}, "material")
+	production.AddMaterialsTech(&lido.Concept{Terms: []*lido.Term{{Value: "painting"}}}, "technique")
+
+	acquisition := desc.AddEvent(lido.NewURIConcept("http://terminology.lido-schema.org/lido00001", "Acquisition", "en"))
+	uffizi := &lido.Actor{}
+	uffizi.AppendID("VIAF", lido.URIType, "http://viaf.org/viaf/155675539")
+	acquisition.AddActor(uffizi, nil)
+	desc.AddEvent(lido.NewURIConcept("http://terminology.lido-schema.org/lido00030", "Exhibition", "en"))
+	return l
+}
+
+func TestFromLido(t *testing.T) {
+	g, err := FromLido(testRecord(), Options{BaseURI: "http://data.example.org/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	object := IRI("http://data.example.org/object/rec%201")
+
+	has := func(s, p IRI, o Term) {
+		t.Helper()
+		for _, got := range g.Objects(s, p) {
+			if got == o {
+				return
+			}
+		}
+		t.Errorf("missing %s", Triple{s, p, o})
+	}
+	one := func(s, p IRI) IRI {
+		t.Helper()
+		objects := g.Objects(s, p)
+		if len(objects) != 1 {
+			t.Fatalf("%s %s: got %v, want one object", s, p, objects)
+		}
+		return objects[0].(IRI)
+	}
+
+	has(object, RDFType, E22ManMadeObject)
+	has(object, RDFSLabel, Literal{Value: "Primavera", Lang: "it"})
+	has(one(object, P102HasTitle), P190HasSymbolicContent, Literal{Value: "Primavera", Lang: "it"})
+	has(object, P2HasType, IRI("http://vocab.getty.edu/aat/300033618"))
+	has(IRI("http://vocab.getty.edu/aat/300033618"), RDFSLabel, Literal{Value: "dipinto", Lang: "it"})
+	if ids := g.Objects(object, P1IsIdentifiedBy); len(ids) != 2 {
+		t.Errorf("identifiers: got %v", ids)
+	}
+
+	uffizi := IRI("http://viaf.org/viaf/155675539")
+	has(object, P50HasCurrentKeeper, uffizi)
+	has(uffizi, RDFType, E39Actor)
+
+	dimensions := g.Objects(object, P43HasDimension)
+	if len(dimensions) != 2 {
+		t.Fatalf("dimensions: got %v", dimensions)
+	}
+	height := dimensions[0].(IRI)
+	has(height, RDFType, E54Dimension)
+	has(height, P90HasValue, Literal{Value: "207", Datatype: XSDNamespace + "decimal"})
+	if one(height, P91HasUnit) != one(dimensions[1].(IRI), P91HasUnit) {
+		t.Error("units of the same name are different nodes")
+	}
+
+	productions := g.Instances(E12Production)
+	if len(productions) != 1 {
+		t.Fatalf("productions: got %v", productions)
+	}
+	production := productions[0]
+	has(production, P108HasProduced, object)
+	if types := g.Objects(production, P2HasType); len(types) != 0 {
+		t.Errorf("CRM event type became a type: %v", types)
+	}
+	artist := IRI("http://vocab.getty.edu/ulan/500010368")
+	has(production, P14CarriedOutBy, artist)
+	has(artist, RDFSLabel, Literal{Value: "Botticelli, Sandro", Lang: "it"})
+	place := one(production, P7TookPlaceAt)
+	has(place, RDFType, E53Place)
+	has(place, RDFSLabel, Literal{Value: "Firenze", Lang: "it"})
+	span := one(production, P4HasTimeSpan)
+	has(span, RDFType, E52TimeSpan)
+	has(span, RDFSLabel, Literal{Value: "um 1480", Lang: "de"})
+	has(span, P82aBeginOfTheBegin, Literal{Value: "1478", Datatype: XSDNamespace + "gYear"})
+	has(span, P82bEndOfTheEnd, Literal{Value: "1482-12-31", Datatype: XSDNamespace + "date"})
+	material := one(production, P126Employed)
+	has(material, RDFType, E57Material)
+	has(object, P45ConsistsOf, material)
+	has(one(production, P32UsedGeneralTechnique), RDFSLabel, Literal{Value: "painting", Lang: "it"})
+
+	acquisitions := g.Instances(E8Acquisition)
+	if len(acquisitions) != 1 {
+		t.Fatalf("acquisitions: got %v", acquisitions)
+	}
+	has(acquisitions[0], P24TransferredTitleOf, object)
+	has(acquisitions[0], P14CarriedOutBy, uffizi)
+	has(acquisitions[0], P2HasType, IRI("http://terminology.lido-schema.org/lido00001"))
+
+	events := g.Instances(E5Event)
+	if len(events) != 1 {
+		t.Fatalf("other events: got %v", events)
+	}
+	has(events[0], P12OccurredInThePresenceOf, object)
+}
+
+func TestCategory(t *testing.T) {
+	l := testRecord()
+	l.Category = lido.NewURIConcept(Namespace+"E78_Collection", "Collection", "en")
+	g, err := FromLido(l, Options{BaseURI: "http://data.example.org/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := g.Instances(E78Collection); len(got) != 1 {
+		t.Errorf("got %v, want the object as E78", got)
+	}
+	if got := g.Instances(E22ManMadeObject); len(got) != 0 {
+		t.Errorf("got %v as E22 too", got)
+	}
+
+	if _, err := FromLido(&lido.Lido{}, Options{BaseURI: "http://data.example.org/"}); err == nil {
+		t.Error("no error for record without lidoRecID")
+	}
+	if _, err := FromLido(l, Options{}); err == nil {
+		t.Error("no error without base URI")
+	}
+	if _, err := FromLido(nil, Options{BaseURI: "http://data.example.org/"}); err == nil {
+		t.Error("no error for nil record")
+	}
+}
+
+func TestMeasurementValues(t *testing.T) {
+	l := &lido.Lido{}
+	l.AppendRecID("test", lido.LocalRecordType, "rec 1")
+	desc := l.CreateDesc("en")
+	values := map[string]bool{"12.50": true, "-3": true, "NaN": false, "Inf": false, "1e3": false, "0x1p-2": false}
+	for value := range values {
+		desc.AddMeasurement("height", "cm", value)
+	}
+	g, err := FromLido(l, Options{BaseURI: "http://data.example.org/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dimension := range g.Instances(E54Dimension) {
+		for _, o := range g.Objects(dimension, P90HasValue) {
+			value := o.(Literal)
+			if decimal := value.Datatype == XSDNamespace+"decimal"; decimal != values[value.Value] {
+				t.Errorf("%q typed as decimal: %v", value.Value, decimal)
+			}
+		}
+	}
+}
+
+func TestEventClass(t *testing.T) {
+	for _, test := range []struct {
+		eventType *lido.Concept
+		want      IRI
+	}{
+		{lido.NewURIConcept(lido.ProductionEventType, "Produktion", "de"), E12Production},
+		{lido.NewURIConcept("http://example.org/event", "Herstellung", "de"), E12Production},
+		{lido.NewURIConcept(string(E8Acquisition), "Acquisition", "en"), E8Acquisition},
+		{lido.NewURIConcept("http://terminology.lido-schema.org/lido00030", "Exhibition", "en"), E5Event},
+	} {
+		event := &lido.Event{EventTypes: []*lido.Concept{test.eventType}}
+		if got := eventClass(event); got != test.want {
+			t.Errorf("%s: got %s, want %s", test.eventType.PreferredTerm(""), got, test.want)
+		}
+	}
+}
+
+func TestWriteNTriples(t *testing.T) {
+	g := NewGraph()
+	s := IRI("http://example.org/a b")
+	g.Add(s, RDFSLabel, Literal{Value: "say \"hi\"\n", Lang: "en"})
+	g.Add(s, P90HasValue, Literal{Value: "1.5", Datatype: XSDNamespace + "decimal"})
+	g.Add(s, RDFSLabel, Literal{Value: "say \"hi\"\n", Lang: "en"})
+
+	var buf bytes.Buffer
+	if err := g.WriteNTriples(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		`<http://example.org/a\u0020b> <http://www.w3.org/2000/01/rdf-schema#label> "say \"hi\"\n"@en .`,
+		`<http://example.org/a\u0020b> <http://www.cidoc-crm.org/cidoc-crm/P90_has_value> "1.5"^^<http://www.w3.org/2001/XMLSchema#decimal> .`,
+		``,
+	}, "\n")
+	if buf.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", buf.String(), want)
+	}
+}
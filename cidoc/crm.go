@@ -0,0 +1,57 @@
+package cidoc
+
+import "github.com/verisart/cidoccrm/crm"
+
+// The CRM classes used by the export, as named by the crm package.
+var (
+	E5Event               = IRI(crm.E5.IRI())
+	E8Acquisition         = IRI(crm.E8.IRI())
+	E12Production         = IRI(crm.E12.IRI())
+	E22ManMadeObject      = IRI(crm.E22.IRI())
+	E25ManMadeFeature     = IRI(crm.E25.IRI())
+	E35Title              = IRI(crm.E35.IRI())
+	E39Actor              = IRI(crm.E39.IRI())
+	E42Identifier         = IRI(crm.E42.IRI())
+	E52TimeSpan           = IRI(crm.E52.IRI())
+	E53Place              = IRI(crm.E53.IRI())
+	E54Dimension          = IRI(crm.E54.IRI())
+	E55Type               = IRI(crm.E55.IRI())
+	E57Material           = IRI(crm.E57.IRI())
+	E58MeasurementUnit    = IRI(crm.E58.IRI())
+	E78Collection         = IRI(crm.E78.IRI())
+	E84InformationCarrier = IRI(crm.E84.IRI())
+)
+
+// The CRM properties used by the export.
+const (
+	P1IsIdentifiedBy           = IRI(Namespace + "P1_is_identified_by")
+	P2HasType                  = IRI(Namespace + "P2_has_type")
+	P3HasNote                  = IRI(Namespace + "P3_has_note")
+	P4HasTimeSpan              = IRI(Namespace + "P4_has_time-span")
+	P7TookPlaceAt              = IRI(Namespace + "P7_took_place_at")
+	P12OccurredInThePresenceOf = IRI(Namespace + "P12_occurred_in_the_presence_of")
+	P14CarriedOutBy            = IRI(Namespace + "P14_carried_out_by")
+	P24TransferredTitleOf      = IRI(Namespace + "P24_transferred_title_of")
+	P32UsedGeneralTechnique    = IRI(Namespace + "P32_used_general_technique")
+	P43HasDimension            = IRI(Namespace + "P43_has_dimension")
+	P45ConsistsOf              = IRI(Namespace + "P45_consists_of")
+	P50HasCurrentKeeper        = IRI(Namespace + "P50_has_current_keeper")
+	P55HasCurrentLocation      = IRI(Namespace + "P55_has_current_location")
+	P82aBeginOfTheBegin        = IRI(Namespace + "P82a_begin_of_the_begin")
+	P82bEndOfTheEnd            = IRI(Namespace + "P82b_end_of_the_end")
+	P89FallsWithin             = IRI(Namespace + "P89_falls_within")
+	P90HasValue                = IRI(Namespace + "P90_has_value")
+	P91HasUnit                 = IRI(Namespace + "P91_has_unit")
+	P102HasTitle               = IRI(Namespace + "P102_has_title")
+	P108HasProduced            = IRI(Namespace + "P108_has_produced")
+	P126Employed               = IRI(Namespace + "P126_employed")
+	P190HasSymbolicContent     = IRI(Namespace + "P190_has_symbolic_content")
+)
+
+// The classes a LIDO category may name, by CRM code.
+var objectClasses = map[string]IRI{
+	"E22": E22ManMadeObject,
+	"E25": E25ManMadeFeature,
+	"E78": E78Collection,
+	"E84": E84InformationCarrier,
+}
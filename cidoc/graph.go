@@ -0,0 +1,161 @@
+// Package cidoc exports LIDO records as CIDOC CRM (http://www.cidoc-crm.org/)
+// triples. The result is an in-memory RDF graph that can be queried and
+// written as N-Triples.
+package cidoc
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	RDFNamespace  = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	RDFSNamespace = "http://www.w3.org/2000/01/rdf-schema#"
+	XSDNamespace  = "http://www.w3.org/2001/XMLSchema#"
+
+	// The namespace of the CRM classes and properties in RDF.
+	Namespace = "http://www.cidoc-crm.org/cidoc-crm/"
+
+	RDFType   = IRI(RDFNamespace + "type")
+	RDFSLabel = IRI(RDFSNamespace + "label")
+)
+
+// A node or predicate of the graph, or an object of a triple.
+type Term interface {
+	// Returns the term in N-Triples syntax.
+	String() string
+}
+
+// An IRI naming a resource.
+type IRI string
+
+func (i IRI) String() string {
+	var b strings.Builder
+	b.WriteByte('<')
+	for _, r := range string(i) {
+		switch {
+		case r <= 0x20, strings.ContainsRune("<>\"{}|^`\\", r):
+			fmt.Fprintf(&b, "\\u%04X", r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('>')
+	return b.String()
+}
+
+// A literal value with either a language tag or a datatype. Plain literals
+// have neither.
+type Literal struct {
+	Value string
+
+	Lang string
+
+	Datatype IRI
+}
+
+func (l Literal) String() string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range l.Value {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	switch {
+	case l.Lang != "":
+		b.WriteString("@" + l.Lang)
+	case l.Datatype != "":
+		b.WriteString("^^" + l.Datatype.String())
+	}
+	return b.String()
+}
+
+// A statement about a resource.
+type Triple struct {
+	Subject IRI
+
+	Predicate IRI
+
+	Object Term
+}
+
+func (t Triple) String() string {
+	return t.Subject.String() + " " + t.Predicate.String() + " " + t.Object.String() + " ."
+}
+
+// A set of triples, kept in the order they were added.
+type Graph struct {
+	Triples []Triple
+
+	// The N-Triples lines of the triples, as a Term may not be comparable.
+	seen map[string]bool
+}
+
+func NewGraph() *Graph {
+	return &Graph{seen: map[string]bool{}}
+}
+
+// Adds a triple, unless the graph already contains it.
+func (g *Graph) Add(s, p IRI, o Term) {
+	t := Triple{s, p, o}
+	if g.seen == nil {
+		g.seen = map[string]bool{}
+	}
+	key := t.String()
+	if g.seen[key] {
+		return
+	}
+	g.seen[key] = true
+	g.Triples = append(g.Triples, t)
+}
+
+// Returns the objects of the triples with subject s and predicate p.
+func (g *Graph) Objects(s, p IRI) (objects []Term) {
+	for _, t := range g.Triples {
+		if t.Subject == s && t.Predicate == p {
+			objects = append(objects, t.Object)
+		}
+	}
+	return
+}
+
+// Returns the subjects of the triples with predicate p and object o.
+func (g *Graph) Subjects(p IRI, o Term) (subjects []IRI) {
+	for _, t := range g.Triples {
+		if t.Predicate == p && t.Object == o {
+			subjects = append(subjects, t.Subject)
+		}
+	}
+	return
+}
+
+// Returns the subjects with the rdf:type class.
+func (g *Graph) Instances(class IRI) []IRI {
+	return g.Subjects(RDFType, class)
+}
+
+// Writes the graph as N-Triples, one triple per line.
+func (g *Graph) WriteNTriples(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, t := range g.Triples {
+		if _, err := bw.WriteString(t.String() + "\n"); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,510 @@
+package cidoc
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/verisart/xsd/lido"
+	"github.com/verisart/xsd/xsdt"
+)
+
+// Controls the export of a LIDO record.
+type Options struct {
+	// The prefix of the URIs minted for the object and for the entities
+	// without a URI of their own, e.g. "http://data.example.org/". Required.
+	BaseURI string
+}
+
+// Exports a LIDO record as CRM. The object is identified by the first
+// lidoRecID, so the record must have one; its URI is BaseURI + "object/" +
+// lidoRecID. Actors, places, concepts and materials with a conceptID,
+// actorID or placeID that is a URI keep it, the other entities get URIs
+// minted below BaseURI.
+//
+// Mapping:
+//   - the object: E22 Man-Made Object, or the CRM class named by the
+//     category if it is E25, E78 or E84
+//   - lidoRecID, objectPublishedID, workID: P1 is identified by an E42
+//     Identifier
+//   - titleSet: P102 has title an E35 Title; preferred values also become
+//     the rdfs:label of the object
+//   - objectWorkType, classification: P2 has type an E55 Type
+//   - objectDescriptionSet: P3 has note
+//   - repositoryName: P50 has current keeper an E39 Actor;
+//     repositoryLocation: P55 has current location an E53 Place
+//   - objectMeasurementsSet: P43 has dimension an E54 Dimension with P2 has
+//     type, P90 has value and P91 has unit
+//   - production and creation events: E12 Production, P108 has produced the
+//     object; acquisition events: E8 Acquisition, P24 transferred title of
+//     the object; other events: E5 Event, P12 occurred in the presence of
+//     the object. The eventType becomes P2 has type unless it names a CRM
+//     class.
+//   - eventActor: P14 carried out by an E39 Actor
+//   - eventPlace: P7 took place at an E53 Place, partOfPlace: P89 falls
+//     within
+//   - eventDate: P4 has time-span an E52 Time-Span with P82a and P82b for
+//     the earliest and latest dates, and the displayDate as rdfs:label
+//   - eventMaterialsTech: materials become P126 employed an E57 Material,
+//     which the object P45 consists of for production events; techniques
+//     (termMaterialsTech of type "technique") P32 used general technique an
+//     E55 Type
+//
+// Added search terms are left out.
+func FromLido(l *lido.Lido, opts Options) (*Graph, error) {
+	if opts.BaseURI == "" {
+		return nil, errors.New("cidoc: Options.BaseURI is required")
+	}
+	if l == nil {
+		return nil, errors.New("cidoc: missing lido record")
+	}
+	if len(l.LidoRecIDs) == 0 || l.LidoRecIDs[0] == nil || l.LidoRecIDs[0].Value == "" {
+		return nil, errors.New("cidoc: record has no lidoRecID")
+	}
+	id := url.PathEscape(strings.TrimSpace(string(l.LidoRecIDs[0].Value)))
+
+	e := &exporter{
+		opts:   opts,
+		id:     id,
+		g:      NewGraph(),
+		object: IRI(opts.BaseURI + "object/" + id),
+		keys:   map[string]IRI{},
+		langs:  lido.NewLangResolver(l),
+	}
+	class := E22ManMadeObject
+	if l.Category != nil {
+		for _, cid := range l.Category.ConceptIDs {
+			if c, ok := objectClasses[cid.CRMClass()]; ok {
+				class = c
+			}
+		}
+	}
+	e.g.Add(e.object, RDFType, class)
+
+	for _, rid := range l.LidoRecIDs {
+		if rid != nil {
+			e.identifier(string(rid.Value))
+		}
+	}
+	for _, oid := range l.ObjectPublishedIDs {
+		if oid != nil {
+			e.identifier(string(oid.Value))
+		}
+	}
+	for _, dm := range l.DescriptiveMetadatas {
+		if dm != nil {
+			e.descriptive(dm)
+		}
+	}
+	return e.g, nil
+}
+
+type exporter struct {
+	opts   Options
+	id     string
+	g      *Graph
+	object IRI
+	minted int
+	langs  *lido.LangResolver
+
+	// The URIs of the entities created so far, by URI or by kind and label,
+	// so that an entity named twice becomes one node.
+	keys map[string]IRI
+}
+
+// Returns a new URI for an entity of the specified kind.
+func (e *exporter) mint(kind string) IRI {
+	e.minted++
+	return IRI(e.opts.BaseURI + kind + "/" + e.id + "-" + strconv.Itoa(e.minted))
+}
+
+func (e *exporter) texts(s, p IRI, texts []*lido.Text) {
+	for _, t := range texts {
+		if t != nil && strings.TrimSpace(string(t.Value)) != "" {
+			e.g.Add(s, p, Literal{Value: string(t.Value), Lang: e.langs.Tag(t)})
+		}
+	}
+}
+
+// Adds the values of an appellation as labels of s, only the preferred ones
+// if preferredOnly is set.
+func (e *exporter) labels(s IRI, a *lido.Appellation, preferredOnly bool) {
+	if a == nil {
+		return
+	}
+	for _, v := range a.Values {
+		if v == nil || v.Value == "" || (preferredOnly && v.Pref == lido.Alternate) {
+			continue
+		}
+		e.g.Add(s, RDFSLabel, Literal{Value: string(v.Value), Lang: e.langs.Tag(v)})
+	}
+}
+
+// Returns the node for an entity, creating it with the class if it is new.
+// Entities are keyed by their URI if they have one, and otherwise by key,
+// unless it is empty.
+func (e *exporter) node(class IRI, kind, uri, key string) (node IRI, created bool) {
+	k := uri
+	if k == "" && key != "" {
+		k = string(class) + " " + key
+	}
+	if n, ok := e.keys[k]; ok && k != "" {
+		return n, false
+	}
+	if uri != "" {
+		node = IRI(uri)
+	} else {
+		node = e.mint(kind)
+	}
+	if k != "" {
+		e.keys[k] = node
+	}
+	e.g.Add(node, RDFType, class)
+	return node, true
+}
+
+func (e *exporter) identifier(value string) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return
+	}
+	node, created := e.node(E42Identifier, "identifier", "", value)
+	if created {
+		e.g.Add(node, P190HasSymbolicContent, Literal{Value: value})
+	}
+	e.g.Add(e.object, P1IsIdentifiedBy, node)
+}
+
+// Returns the node for a concept, an instance of class labelled with its
+// terms, or "" if the concept has neither URI nor terms.
+func (e *exporter) concept(class IRI, kind string, c *lido.Concept) IRI {
+	if c == nil {
+		return ""
+	}
+	var key string
+	for _, t := range c.Terms {
+		if t != nil && t.Value != "" && t.AddedSearchTerm != "yes" {
+			key = string(t.Value)
+			break
+		}
+	}
+	uri := lido.URIOf(c.ConceptIDs)
+	if uri == "" && key == "" {
+		return ""
+	}
+	node, created := e.node(class, kind, uri, key)
+	if created {
+		for _, t := range c.Terms {
+			if t != nil && t.Value != "" && t.AddedSearchTerm != "yes" {
+				e.g.Add(node, RDFSLabel, Literal{Value: string(t.Value), Lang: e.langs.Tag(t)})
+			}
+		}
+	}
+	return node
+}
+
+func (e *exporter) actor(actor *lido.Actor) IRI {
+	node, created := e.node(E39Actor, "actor", lido.URIOf(actor.ActorIDs), "")
+	if created {
+		for _, name := range actor.NameActorSets {
+			e.labels(node, name, false)
+		}
+	}
+	return node
+}
+
+func (e *exporter) legalBody(body *lido.LegalBodyRef) IRI {
+	node, created := e.node(E39Actor, "actor", lido.URIOf(body.LegalBodyIDs), "")
+	if created {
+		for _, name := range body.LegalBodyNames {
+			e.labels(node, name, false)
+		}
+	}
+	return node
+}
+
+func (e *exporter) place(place *lido.Place) IRI {
+	node, created := e.node(E53Place, "place", lido.URIOf(place.PlaceIDs), "")
+	if !created {
+		return node
+	}
+	for _, name := range place.NamePlaceSets {
+		e.labels(node, name, false)
+	}
+	for _, part := range place.PartOfPlaces {
+		if part != nil {
+			e.g.Add(node, P89FallsWithin, e.place(part))
+		}
+	}
+	return node
+}
+
+var (
+	yearPattern      = regexp.MustCompile(`^-?\d{4,}$`)
+	yearMonthPattern = regexp.MustCompile(`^-?\d{4,}-\d{2}$`)
+	datePattern      = regexp.MustCompile(`^-?\d{4,}-\d{2}-\d{2}$`)
+	dateTimePattern  = regexp.MustCompile(`^-?\d{4,}-\d{2}-\d{2}T`)
+)
+
+// Returns a date as a literal typed by its precision, xsd:gYear,
+// xsd:gYearMonth, xsd:date or xsd:dateTime, or as a plain literal if it is
+// none of these.
+func dateLiteral(value string) Literal {
+	l := Literal{Value: value}
+	switch {
+	case yearPattern.MatchString(value):
+		l.Datatype = XSDNamespace + "gYear"
+	case yearMonthPattern.MatchString(value):
+		l.Datatype = XSDNamespace + "gYearMonth"
+	case datePattern.MatchString(value):
+		l.Datatype = XSDNamespace + "date"
+	case dateTimePattern.MatchString(value):
+		l.Datatype = XSDNamespace + "dateTime"
+	}
+	return l
+}
+
+func (e *exporter) timeSpan(s IRI, set *lido.DateSet) {
+	var earliest, latest string
+	if set.Date != nil {
+		if set.Date.EarliestDate != nil {
+			earliest = strings.TrimSpace(string(set.Date.EarliestDate.Value))
+		}
+		if set.Date.LatestDate != nil {
+			latest = strings.TrimSpace(string(set.Date.LatestDate.Value))
+		}
+	}
+	if earliest == "" && latest == "" && len(set.DisplayDates) == 0 {
+		return
+	}
+	node := e.mint("timespan")
+	e.g.Add(node, RDFType, E52TimeSpan)
+	e.g.Add(s, P4HasTimeSpan, node)
+	e.texts(node, RDFSLabel, set.DisplayDates)
+	if earliest != "" {
+		e.g.Add(node, P82aBeginOfTheBegin, dateLiteral(earliest))
+	}
+	if latest != "" {
+		e.g.Add(node, P82bEndOfTheEnd, dateLiteral(latest))
+	}
+}
+
+func (e *exporter) descriptive(dm *lido.DescriptiveMetadata) {
+	for _, title := range dm.ObjectID.TitleWrap.Titles {
+		if title == nil || len(title.Values) == 0 {
+			continue
+		}
+		node := e.mint("title")
+		e.g.Add(node, RDFType, E35Title)
+		e.g.Add(e.object, P102HasTitle, node)
+		for _, v := range title.Values {
+			if v != nil && v.Value != "" {
+				e.g.Add(node, P190HasSymbolicContent, Literal{Value: string(v.Value), Lang: e.langs.Tag(v)})
+			}
+		}
+		if title.Type != lido.AlternateTitle {
+			e.labels(e.object, &title.Appellation, true)
+		}
+	}
+
+	var types []*lido.ClassificationElement
+	types = append(types, dm.ObjectClass.WorkType.Types...)
+	if dm.ObjectClass.ClassificationWrap != nil {
+		types = append(types, dm.ObjectClass.ClassificationWrap.Classifications...)
+	}
+	for _, t := range types {
+		if t == nil {
+			continue
+		}
+		if node := e.concept(E55Type, "type", &t.Concept); node != "" {
+			e.g.Add(e.object, P2HasType, node)
+		}
+	}
+
+	if dm.ObjectID.Description != nil {
+		for _, note := range dm.ObjectID.Description.Notes {
+			if note != nil {
+				e.texts(e.object, P3HasNote, note.Values)
+			}
+		}
+	}
+
+	if dm.ObjectID.RepositoryWrap != nil {
+		for _, repository := range dm.ObjectID.RepositoryWrap.Repositories {
+			if repository == nil {
+				continue
+			}
+			if repository.RepositoryName != nil {
+				e.g.Add(e.object, P50HasCurrentKeeper, e.legalBody(repository.RepositoryName))
+			}
+			if repository.RepositoryLocation != nil {
+				e.g.Add(e.object, P55HasCurrentLocation, e.place(repository.RepositoryLocation))
+			}
+			for _, id := range repository.WorkIDs {
+				if id != nil {
+					e.identifier(string(id.XsdtString))
+				}
+			}
+		}
+	}
+
+	if dm.ObjectID.MeasurementsWrap != nil {
+		e.measurements(dm.ObjectID.MeasurementsWrap)
+	}
+
+	if dm.EventWrap != nil {
+		for _, set := range dm.EventWrap.Events {
+			if set != nil && set.Event != nil {
+				e.event(set.Event)
+			}
+		}
+	}
+}
+
+// Returns the node of the E55 Type or E58 Measurement Unit labelled by the
+// first of the texts, or "" if there is none.
+func (e *exporter) labelled(class IRI, kind string, texts []*lido.Text) IRI {
+	var key string
+	for _, t := range texts {
+		if t != nil && strings.TrimSpace(string(t.Value)) != "" {
+			key = strings.TrimSpace(string(t.Value))
+			break
+		}
+	}
+	if key == "" {
+		return ""
+	}
+	node, created := e.node(class, kind, "", key)
+	if created {
+		e.texts(node, RDFSLabel, texts)
+	}
+	return node
+}
+
+func (e *exporter) measurements(wrap *lido.MeasurementsWrap) {
+	for _, set := range wrap.MeasurementsSets {
+		if set == nil || set.Measurements == nil {
+			continue
+		}
+		for _, m := range set.Measurements.MeasurementsSets {
+			if m == nil || strings.TrimSpace(string(m.Value.Value)) == "" {
+				continue
+			}
+			node := e.mint("dimension")
+			e.g.Add(node, RDFType, E54Dimension)
+			e.g.Add(e.object, P43HasDimension, node)
+			value := Literal{Value: strings.TrimSpace(string(m.Value.Value))}
+			var d xsdt.Decimal
+			if d.Parse(value.Value) == nil {
+				value.Datatype = XSDNamespace + "decimal"
+			}
+			e.g.Add(node, P90HasValue, value)
+			if t := e.labelled(E55Type, "type", m.Types); t != "" {
+				e.g.Add(node, P2HasType, t)
+			}
+			if unit := e.labelled(E58MeasurementUnit, "unit", m.Units); unit != "" {
+				e.g.Add(node, P91HasUnit, unit)
+			}
+		}
+	}
+}
+
+// Returns the CRM class of an event by its eventType: E12 Production for the
+// events reported by lido.Event.IsCreation, else E8 Acquisition for the CRM
+// class E8, as written by lido.Event.AppendCRMType, or the term acquisition.
+func eventClass(event *lido.Event) IRI {
+	if event.IsCreation() {
+		return E12Production
+	}
+	for _, t := range event.EventTypes {
+		if t == nil {
+			continue
+		}
+		for _, id := range t.ConceptIDs {
+			if id.CRMClass() == "E8" {
+				return E8Acquisition
+			}
+		}
+		for _, term := range t.Terms {
+			if term == nil {
+				continue
+			}
+			switch strings.ToLower(strings.TrimSpace(string(term.Value))) {
+			case "acquisition", "erwerb":
+				return E8Acquisition
+			}
+		}
+	}
+	return E5Event
+}
+
+func (e *exporter) event(event *lido.Event) {
+	node := IRI(lido.URIOf(event.EventIDs))
+	if node == "" {
+		node = e.mint("event")
+	}
+	class := eventClass(event)
+	e.g.Add(node, RDFType, class)
+	switch class {
+	case E12Production:
+		e.g.Add(node, P108HasProduced, e.object)
+	case E8Acquisition:
+		e.g.Add(node, P24TransferredTitleOf, e.object)
+	default:
+		e.g.Add(node, P12OccurredInThePresenceOf, e.object)
+	}
+	for _, t := range event.EventTypes {
+		if t == nil {
+			continue
+		}
+		isClass := false
+		for _, id := range t.ConceptIDs {
+			isClass = isClass || id.CRMClass() != ""
+		}
+		if !isClass {
+			if typ := e.concept(E55Type, "type", t); typ != "" {
+				e.g.Add(node, P2HasType, typ)
+			}
+		}
+	}
+
+	for _, actor := range event.EventActors {
+		if actor != nil && actor.Actor != nil {
+			e.g.Add(node, P14CarriedOutBy, e.actor(actor.Actor))
+		}
+	}
+	for _, place := range event.EventPlaces {
+		if place != nil && place.Place != nil {
+			e.g.Add(node, P7TookPlaceAt, e.place(place.Place))
+		}
+	}
+	if event.Date != nil {
+		e.timeSpan(node, event.Date)
+	}
+	for _, mt := range event.EventMaterialsTechs {
+		if mt == nil || mt.MaterialsTech == nil {
+			continue
+		}
+		for _, term := range mt.MaterialsTech.TermMaterialsTechs {
+			if term == nil {
+				continue
+			}
+			if strings.EqualFold(string(term.Type), "technique") {
+				if t := e.concept(E55Type, "type", &term.Concept); t != "" {
+					e.g.Add(node, P32UsedGeneralTechnique, t)
+				}
+				continue
+			}
+			material := e.concept(E57Material, "material", &term.Concept)
+			if material == "" {
+				continue
+			}
+			e.g.Add(node, P126Employed, material)
+			if class == E12Production {
+				e.g.Add(e.object, P45ConsistsOf, material)
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Package dc holds simple (unqualified) Dublin Core records in the oai_dc
+// format required by OAI-PMH, and a crosswalk from LIDO records.
+package dc
+
+import (
+	"encoding/xml"
+)
+
+const (
+	// The namespace of the Dublin Core elements.
+	Namespace = "http://purl.org/dc/elements/1.1/"
+
+	// The namespace of the oai_dc container element.
+	OaiDCNamespace = "http://www.openarchives.org/OAI/2.0/oai_dc/"
+
+	// The location of the oai_dc schema.
+	OaiDCSchema = "http://www.openarchives.org/OAI/2.0/oai_dc.xsd"
+
+	xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+)
+
+// The value of a Dublin Core element, with an optional language tag.
+type Element struct {
+	Value string `xml:",chardata"`
+
+	Lang string `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+}
+
+// A simple Dublin Core record, the oai_dc:dc element. All elements are
+// optional and repeatable. It marshals with the conventional oai_dc and dc
+// prefixes.
+type OaiDC struct {
+	XMLName xml.Name `xml:"http://www.openarchives.org/OAI/2.0/oai_dc/ dc"`
+
+	Titles []*Element `xml:"http://purl.org/dc/elements/1.1/ title"`
+
+	Creators []*Element `xml:"http://purl.org/dc/elements/1.1/ creator"`
+
+	Subjects []*Element `xml:"http://purl.org/dc/elements/1.1/ subject"`
+
+	Descriptions []*Element `xml:"http://purl.org/dc/elements/1.1/ description"`
+
+	Publishers []*Element `xml:"http://purl.org/dc/elements/1.1/ publisher"`
+
+	Contributors []*Element `xml:"http://purl.org/dc/elements/1.1/ contributor"`
+
+	Dates []*Element `xml:"http://purl.org/dc/elements/1.1/ date"`
+
+	Types []*Element `xml:"http://purl.org/dc/elements/1.1/ type"`
+
+	Formats []*Element `xml:"http://purl.org/dc/elements/1.1/ format"`
+
+	Identifiers []*Element `xml:"http://purl.org/dc/elements/1.1/ identifier"`
+
+	Sources []*Element `xml:"http://purl.org/dc/elements/1.1/ source"`
+
+	Languages []*Element `xml:"http://purl.org/dc/elements/1.1/ language"`
+
+	Relations []*Element `xml:"http://purl.org/dc/elements/1.1/ relation"`
+
+	Coverages []*Element `xml:"http://purl.org/dc/elements/1.1/ coverage"`
+
+	Rights []*Element `xml:"http://purl.org/dc/elements/1.1/ rights"`
+}
+
+// Writes the record with the oai_dc and dc prefixes and the schema location,
+// as aggregators expect, instead of a default namespace on every element.
+func (r *OaiDC) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	root := xml.StartElement{
+		Name: xml.Name{Local: "oai_dc:dc"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "xmlns:oai_dc"}, Value: OaiDCNamespace},
+			{Name: xml.Name{Local: "xmlns:dc"}, Value: Namespace},
+			{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+			{Name: xml.Name{Local: "xsi:schemaLocation"}, Value: OaiDCNamespace + " " + OaiDCSchema},
+		},
+	}
+	if err := e.EncodeToken(root); err != nil {
+		return err
+	}
+	for _, field := range []struct {
+		name     string
+		elements []*Element
+	}{
+		{"title", r.Titles},
+		{"creator", r.Creators},
+		{"subject", r.Subjects},
+		{"description", r.Descriptions},
+		{"publisher", r.Publishers},
+		{"contributor", r.Contributors},
+		{"date", r.Dates},
+		{"type", r.Types},
+		{"format", r.Formats},
+		{"identifier", r.Identifiers},
+		{"source", r.Sources},
+		{"language", r.Languages},
+		{"relation", r.Relations},
+		{"coverage", r.Coverages},
+		{"rights", r.Rights},
+	} {
+		for _, el := range field.elements {
+			if el == nil {
+				continue
+			}
+			start := xml.StartElement{Name: xml.Name{Local: "dc:" + field.name}}
+			if el.Lang != "" {
+				start.Attr = []xml.Attr{{Name: xml.Name{Local: "xml:lang"}, Value: el.Lang}}
+			}
+			if err := e.EncodeToken(start); err != nil {
+				return err
+			}
+			if err := e.EncodeToken(xml.CharData(el.Value)); err != nil {
+				return err
+			}
+			if err := e.EncodeToken(start.End()); err != nil {
+				return err
+			}
+		}
+	}
+	return e.EncodeToken(root.End())
+}
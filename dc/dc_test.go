@@ -0,0 +1,100 @@
+package dc
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+
+	"github.com/verisart/xsd/lido"
+)
+
+func TestFromLido(t *testing.T) {
+	if r := FromLido(nil); !reflect.DeepEqual(r, &OaiDC{}) {
+		t.Errorf("nil record gave %+v", r)
+	}
+
+	l := &lido.Lido{ObjectPublishedIDs: []*lido.Identifier{{Value: "http://example.org/obj/1", Type: lido.URIType}}}
+	desc := l.CreateDesc("de")
+	desc.AppendAATWorkType(lido.URIType, "http://vocab.getty.edu/aat/300033618", "Gemälde")
+	title := lido.NewTitle("Frühling", "", true, lido.RepositoryTitle)
+	title.Append("Spring", "en", false)
+	desc.ObjectID.TitleWrap.Append(title)
+	desc.ObjectID.RepositoryWrap = &lido.RepositoryWrap{Repositories: []*lido.Repository{{
+		WorkIDs: []*lido.WorkID{{XsdtString: "Inv. 8360"}},
+	}}}
+	desc.EventWrap = &lido.EventWrap{}
+	desc.EventWrap.AppendEvent(&lido.Event{
+		EventTypes: []*lido.Concept{lido.NewURIConcept("http://terminology.lido-schema.org/lido00007", "Production", "en")},
+		Date:       &lido.DateSet{Date: &lido.DateSpan{EarliestDate: &lido.Date{Value: "1478"}, LatestDate: &lido.Date{Value: "1482"}}},
+		EventActors: []*lido.EventActor{{ActorInRole: lido.ActorInRole{Actor: &lido.Actor{
+			NameActorSets: []*lido.Appellation{{Values: []*lido.AppellationValue{
+				{Value: "Botticelli, Sandro", Pref: lido.Preferred},
+				{Value: "Filipepi, Alessandro", Pref: lido.Alternate},
+			}}},
+		}}}},
+	})
+	desc.EventWrap.AppendEvent(&lido.Event{
+		EventTypes: []*lido.Concept{lido.NewURIConcept("http://terminology.lido-schema.org/lido00001", "Acquisition", "en")},
+		Date:       &lido.DateSet{DisplayDates: []*lido.Text{{Value: "1815"}}},
+	})
+	desc.ObjectRelationWrap = &lido.ObjectRelationWrap{SubjectWrap: &lido.SubjectWrap{SubjectSets: []*lido.SubjectSet{
+		{Subject: &lido.Subject{
+			SubjectConcepts: []*lido.ConceptElement{{Concept: lido.Concept{Terms: []*lido.Term{
+				{Value: "Venus"}, {Value: "Aphrodite", AddedSearchTerm: "yes"},
+			}}}},
+			SubjectPlaces: []*lido.PlaceSet{{DisplayPlaces: []*lido.Text{{Value: "Arkadien"}}}},
+		}},
+		{DisplaySubjects: []*lido.Text{{Value: "Allegorie", Lang: "de"}}},
+	}}}
+	l.AdministrativeMetadatas = []*lido.AdministrativeMetadata{{
+		Lang: "en",
+		RightsWorkWrap: &lido.RightsWorkWrap{RightsWorkSets: []*lido.Rights{{
+			CreditLines: []*lido.Text{{Value: "Gallerie degli Uffizi"}},
+		}}},
+	}}
+
+	got := FromLido(l)
+	want := &OaiDC{
+		Titles:      []*Element{{"Frühling", "de"}, {"Spring", "en"}},
+		Creators:    []*Element{{"Botticelli, Sandro", "de"}},
+		Subjects:    []*Element{{"Venus", "de"}, {"Allegorie", "de"}},
+		Dates:       []*Element{{"1478/1482", ""}, {"1815", "de"}},
+		Types:       []*Element{{"Gemälde", "de"}},
+		Identifiers: []*Element{{"http://example.org/obj/1", ""}, {"Inv. 8360", ""}},
+		Languages:   []*Element{{"de", ""}},
+		Coverages:   []*Element{{"Arkadien", "de"}},
+		Rights:      []*Element{{"Gallerie degli Uffizi", "en"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		gotXML, _ := xml.Marshal(got)
+		wantXML, _ := xml.Marshal(want)
+		t.Errorf("got\n%s\nwant\n%s", gotXML, wantXML)
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	r := &OaiDC{
+		Titles:      []*Element{{"Primavera", "it"}},
+		Identifiers: []*Element{{"Inv. 8360", ""}},
+	}
+	data, err := xml.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<oai_dc:dc xmlns:oai_dc="http://www.openarchives.org/OAI/2.0/oai_dc/" xmlns:dc="http://purl.org/dc/elements/1.1/"` +
+		` xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"` +
+		` xsi:schemaLocation="http://www.openarchives.org/OAI/2.0/oai_dc/ http://www.openarchives.org/OAI/2.0/oai_dc.xsd">` +
+		`<dc:title xml:lang="it">Primavera</dc:title><dc:identifier>Inv. 8360</dc:identifier></oai_dc:dc>`
+	if string(data) != want {
+		t.Errorf("got\n%s\nwant\n%s", data, want)
+	}
+
+	var back OaiDC
+	if err := xml.Unmarshal(data, &back); err != nil {
+		t.Fatal(err)
+	}
+	back.XMLName = xml.Name{}
+	if !reflect.DeepEqual(&back, r) {
+		t.Errorf("round trip: got %+v", back)
+	}
+}
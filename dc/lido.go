@@ -0,0 +1,224 @@
+package dc
+
+import (
+	"strings"
+
+	"github.com/verisart/xsd/lido"
+)
+
+// Crosswalks a LIDO record to simple Dublin Core. The mapping is lossy: the
+// structure of LIDO (events, roles, concept identifiers, sort orders, and
+// the distinction between preferred and alternate values) is flattened into
+// plain strings, and everything not listed below is dropped.
+//
+//   - dc:title: every appellation value of every titleSet
+//   - dc:creator: the preferred names of the actors of production and
+//     creation events, or their displayActorInRole if they have none
+//   - dc:contributor: likewise for the actors of other events
+//   - dc:date: the displayDate of every event, or else its date span as
+//     "earliest/latest" (a single date if both are equal)
+//   - dc:subject: the terms of subjectConcept, the preferred names of
+//     subjectActor, and displaySubject where there is no structured subject
+//   - dc:coverage: the names of subjectPlace and the spans of subjectDate
+//   - dc:type: the terms of objectWorkType
+//   - dc:description: the values of objectDescriptionSet
+//   - dc:identifier: objectPublishedID and the workID of every repository
+//   - dc:rights: the creditLine of every rightsWorkSet
+//   - dc:language: the xml:lang of every descriptiveMetadata element
+//
+// Values take their xml:lang as resolved by lido.LangResolver. Added search
+// terms are left out. A nil record gives an empty one.
+func FromLido(l *lido.Lido) *OaiDC {
+	r := &OaiDC{}
+	if l == nil {
+		return r
+	}
+	langs := lido.NewLangResolver(l)
+	for _, id := range l.ObjectPublishedIDs {
+		r.Identifiers = appendValue(r.Identifiers, id != nil, func() *Element { return &Element{Value: string(id.Value)} })
+	}
+	for _, dm := range l.DescriptiveMetadatas {
+		if dm != nil {
+			r.descriptive(dm, langs)
+		}
+	}
+	for _, am := range l.AdministrativeMetadatas {
+		if am == nil || am.RightsWorkWrap == nil {
+			continue
+		}
+		for _, rights := range am.RightsWorkWrap.RightsWorkSets {
+			if rights != nil {
+				r.Rights = append(r.Rights, texts(rights.CreditLines, langs)...)
+			}
+		}
+	}
+	return r
+}
+
+// Appends the element made by fn if ok is set and its value is not blank.
+func appendValue(elements []*Element, ok bool, fn func() *Element) []*Element {
+	if !ok {
+		return elements
+	}
+	if el := fn(); strings.TrimSpace(el.Value) != "" {
+		return append(elements, el)
+	}
+	return elements
+}
+
+func texts(ts []*lido.Text, langs *lido.LangResolver) (elements []*Element) {
+	for _, t := range ts {
+		elements = appendValue(elements, t != nil, func() *Element {
+			return &Element{Value: string(t.Value), Lang: langs.Tag(t)}
+		})
+	}
+	return
+}
+
+// Returns all values of an appellation, or only the preferred ones.
+func appellation(a *lido.Appellation, langs *lido.LangResolver, preferredOnly bool) (elements []*Element) {
+	if a == nil {
+		return
+	}
+	for _, v := range a.Values {
+		if v == nil || (preferredOnly && v.Pref == lido.Alternate) {
+			continue
+		}
+		elements = appendValue(elements, true, func() *Element {
+			return &Element{Value: string(v.Value), Lang: langs.Tag(v)}
+		})
+	}
+	return
+}
+
+func terms(c *lido.Concept, langs *lido.LangResolver) (elements []*Element) {
+	if c == nil {
+		return
+	}
+	for _, t := range c.Terms {
+		if t == nil || t.AddedSearchTerm == "yes" {
+			continue
+		}
+		elements = appendValue(elements, true, func() *Element {
+			return &Element{Value: string(t.Value), Lang: langs.Tag(t)}
+		})
+	}
+	return
+}
+
+func actorNames(actor *lido.Actor, langs *lido.LangResolver) (elements []*Element) {
+	if actor == nil {
+		return
+	}
+	for _, name := range actor.NameActorSets {
+		elements = append(elements, appellation(name, langs, true)...)
+	}
+	return
+}
+
+func (r *OaiDC) descriptive(dm *lido.DescriptiveMetadata, langs *lido.LangResolver) {
+	lang := string(dm.Lang)
+	r.Languages = appendValue(r.Languages, lang != "", func() *Element { return &Element{Value: lang} })
+
+	for _, title := range dm.ObjectID.TitleWrap.Titles {
+		if title != nil {
+			r.Titles = append(r.Titles, appellation(&title.Appellation, langs, false)...)
+		}
+	}
+	for _, t := range dm.ObjectClass.WorkType.Types {
+		if t != nil {
+			r.Types = append(r.Types, terms(&t.Concept, langs)...)
+		}
+	}
+	if dm.ObjectID.Description != nil {
+		for _, note := range dm.ObjectID.Description.Notes {
+			if note != nil {
+				r.Descriptions = append(r.Descriptions, texts(note.Values, langs)...)
+			}
+		}
+	}
+	if dm.ObjectID.RepositoryWrap != nil {
+		for _, repository := range dm.ObjectID.RepositoryWrap.Repositories {
+			if repository == nil {
+				continue
+			}
+			for _, id := range repository.WorkIDs {
+				r.Identifiers = appendValue(r.Identifiers, id != nil, func() *Element { return &Element{Value: string(id.XsdtString)} })
+			}
+		}
+	}
+
+	if dm.EventWrap != nil {
+		for _, set := range dm.EventWrap.Events {
+			if set != nil && set.Event != nil {
+				r.event(set.Event, langs)
+			}
+		}
+	}
+
+	if dm.ObjectRelationWrap != nil && dm.ObjectRelationWrap.SubjectWrap != nil {
+		for _, set := range dm.ObjectRelationWrap.SubjectWrap.SubjectSets {
+			if set != nil {
+				r.subject(set, langs)
+			}
+		}
+	}
+}
+
+func (r *OaiDC) event(event *lido.Event, langs *lido.LangResolver) {
+	creation := event.IsCreation()
+	for _, actor := range event.EventActors {
+		if actor == nil {
+			continue
+		}
+		names := actorNames(actor.Actor, langs)
+		if len(names) == 0 {
+			continue
+		}
+		if creation {
+			r.Creators = append(r.Creators, names...)
+		} else {
+			r.Contributors = append(r.Contributors, names...)
+		}
+	}
+	if event.Date != nil {
+		if dates := texts(event.Date.DisplayDates, langs); len(dates) > 0 {
+			r.Dates = append(r.Dates, dates...)
+		} else if event.Date.Date != nil {
+			r.Dates = appendValue(r.Dates, true, func() *Element { return &Element{Value: event.Date.Date.String()} })
+		}
+	}
+}
+
+func (r *OaiDC) subject(set *lido.SubjectSet, langs *lido.LangResolver) {
+	s := set.Subject
+	if s == nil {
+		r.Subjects = append(r.Subjects, texts(set.DisplaySubjects, langs)...)
+		return
+	}
+	for _, c := range s.SubjectConcepts {
+		if c != nil {
+			r.Subjects = append(r.Subjects, terms(&c.Concept, langs)...)
+		}
+	}
+	for _, actor := range s.SubjectActors {
+		if actor != nil {
+			r.Subjects = append(r.Subjects, actorNames(actor.Actor, langs)...)
+		}
+	}
+	for _, place := range s.SubjectPlaces {
+		if place == nil {
+			continue
+		}
+		if place.Place == nil {
+			r.Coverages = append(r.Coverages, texts(place.DisplayPlaces, langs)...)
+			continue
+		}
+		for _, name := range place.Place.NamePlaceSets {
+			r.Coverages = append(r.Coverages, appellation(name, langs, true)...)
+		}
+	}
+	for _, date := range s.SubjectDates {
+		r.Coverages = appendValue(r.Coverages, date != nil, func() *Element { return &Element{Value: date.String()} })
+	}
+}
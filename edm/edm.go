@@ -0,0 +1,227 @@
+// Package edm holds the classes of the Europeana Data Model
+// (https://pro.europeana.eu/page/edm-documentation) that make up a Europeana
+// submission, and a converter from LIDO records. The types only marshal; the
+// element names carry their conventional prefixes, which are declared on the
+// RDF root element.
+package edm
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+const (
+	RDFNamespace     = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	EDMNamespace     = "http://www.europeana.eu/schemas/edm/"
+	ORENamespace     = "http://www.openarchives.org/ore/terms/"
+	DCNamespace      = "http://purl.org/dc/elements/1.1/"
+	DCTermsNamespace = "http://purl.org/dc/terms/"
+	SKOSNamespace    = "http://www.w3.org/2004/02/skos/core#"
+	WGS84Namespace   = "http://www.w3.org/2003/01/geo/wgs84_pos#"
+	RDAGr2Namespace  = "http://rdvocab.info/ElementsGr2/"
+)
+
+// The values of edm:type.
+const (
+	TypeImage = "IMAGE"
+	TypeText  = "TEXT"
+	TypeSound = "SOUND"
+	TypeVideo = "VIDEO"
+	Type3D    = "3D"
+)
+
+// The root element of an EDM document.
+type RDF struct {
+	XMLName xml.Name `xml:"rdf:RDF"`
+
+	XmlnsRDF     string `xml:"xmlns:rdf,attr"`
+	XmlnsEDM     string `xml:"xmlns:edm,attr"`
+	XmlnsORE     string `xml:"xmlns:ore,attr"`
+	XmlnsDC      string `xml:"xmlns:dc,attr"`
+	XmlnsDCTerms string `xml:"xmlns:dcterms,attr"`
+	XmlnsSKOS    string `xml:"xmlns:skos,attr"`
+	XmlnsWGS84   string `xml:"xmlns:wgs84_pos,attr"`
+	XmlnsRDAGr2  string `xml:"xmlns:rdaGr2,attr"`
+
+	ProvidedCHOs []*ProvidedCHO `xml:"edm:ProvidedCHO"`
+
+	WebResources []*WebResource `xml:"edm:WebResource"`
+
+	Agents []*Agent `xml:"edm:Agent"`
+
+	Places []*Place `xml:"edm:Place"`
+
+	Concepts []*Concept `xml:"skos:Concept"`
+
+	Aggregations []*Aggregation `xml:"ore:Aggregation"`
+}
+
+// Returns an empty document with the namespaces declared.
+func NewRDF() *RDF {
+	return &RDF{
+		XmlnsRDF:     RDFNamespace,
+		XmlnsEDM:     EDMNamespace,
+		XmlnsORE:     ORENamespace,
+		XmlnsDC:      DCNamespace,
+		XmlnsDCTerms: DCTermsNamespace,
+		XmlnsSKOS:    SKOSNamespace,
+		XmlnsWGS84:   WGS84Namespace,
+		XmlnsRDAGr2:  RDAGr2Namespace,
+	}
+}
+
+// Writes the document as indented RDF/XML, with an XML declaration.
+func (r *RDF) Encode(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(r); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// A plain literal with an optional language tag.
+type Literal struct {
+	Value string `xml:",chardata"`
+
+	Lang string `xml:"xml:lang,attr,omitempty"`
+}
+
+// A reference to a resource.
+type Resource struct {
+	Resource string `xml:"rdf:resource,attr"`
+}
+
+// A property that is either a literal or a reference to a resource, as most
+// EDM properties may be.
+type LiteralOrResource struct {
+	Value string `xml:",chardata"`
+
+	Lang string `xml:"xml:lang,attr,omitempty"`
+
+	Resource string `xml:"rdf:resource,attr,omitempty"`
+}
+
+// The cultural heritage object itself.
+type ProvidedCHO struct {
+	About string `xml:"rdf:about,attr"`
+
+	Titles []*Literal `xml:"dc:title"`
+
+	Alternatives []*Literal `xml:"dcterms:alternative"`
+
+	Descriptions []*Literal `xml:"dc:description"`
+
+	Creators []*LiteralOrResource `xml:"dc:creator"`
+
+	Contributors []*LiteralOrResource `xml:"dc:contributor"`
+
+	Created []*Literal `xml:"dcterms:created"`
+
+	Identifiers []*Literal `xml:"dc:identifier"`
+
+	Types []*LiteralOrResource `xml:"dc:type"`
+
+	Subjects []*LiteralOrResource `xml:"dc:subject"`
+
+	Mediums []*LiteralOrResource `xml:"dcterms:medium"`
+
+	Extents []*Literal `xml:"dcterms:extent"`
+
+	Spatials []*LiteralOrResource `xml:"dcterms:spatial"`
+
+	Temporals []*LiteralOrResource `xml:"dcterms:temporal"`
+
+	Rights []*LiteralOrResource `xml:"dc:rights"`
+
+	Languages []*Literal `xml:"dc:language"`
+
+	CurrentLocation *Resource `xml:"edm:currentLocation"`
+
+	// One of TypeImage, TypeText, TypeSound, TypeVideo and Type3D.
+	Type string `xml:"edm:type"`
+}
+
+// The provider's aggregation of the object and its digital representations.
+type Aggregation struct {
+	About string `xml:"rdf:about,attr"`
+
+	AggregatedCHO Resource `xml:"edm:aggregatedCHO"`
+
+	DataProvider *LiteralOrResource `xml:"edm:dataProvider"`
+
+	Provider *LiteralOrResource `xml:"edm:provider"`
+
+	// A web page showing the object in its context.
+	IsShownAt *Resource `xml:"edm:isShownAt"`
+
+	// The best digital representation of the object.
+	IsShownBy *Resource `xml:"edm:isShownBy"`
+
+	// A thumbnail of the object.
+	Object *Resource `xml:"edm:object"`
+
+	HasViews []*Resource `xml:"edm:hasView"`
+
+	// A rights statement URI, e.g. from rightsstatements.org or
+	// creativecommons.org.
+	Rights *Resource `xml:"edm:rights"`
+}
+
+// A digital representation of the object.
+type WebResource struct {
+	About string `xml:"rdf:about,attr"`
+
+	Descriptions []*Literal `xml:"dc:description"`
+
+	Formats []*Literal `xml:"dc:format"`
+
+	Rights []*LiteralOrResource `xml:"dc:rights"`
+
+	EDMRights *Resource `xml:"edm:rights"`
+}
+
+// A person or organization.
+type Agent struct {
+	About string `xml:"rdf:about,attr"`
+
+	PrefLabels []*Literal `xml:"skos:prefLabel"`
+
+	AltLabels []*Literal `xml:"skos:altLabel"`
+
+	DateOfBirth []*Literal `xml:"rdaGr2:dateOfBirth"`
+
+	DateOfDeath []*Literal `xml:"rdaGr2:dateOfDeath"`
+
+	Gender []*Literal `xml:"rdaGr2:gender"`
+}
+
+// A spatial location.
+type Place struct {
+	About string `xml:"rdf:about,attr"`
+
+	Lat string `xml:"wgs84_pos:lat,omitempty"`
+
+	Long string `xml:"wgs84_pos:long,omitempty"`
+
+	PrefLabels []*Literal `xml:"skos:prefLabel"`
+
+	AltLabels []*Literal `xml:"skos:altLabel"`
+
+	IsPartOf []*Resource `xml:"dcterms:isPartOf"`
+}
+
+// A concept from a controlled vocabulary.
+type Concept struct {
+	About string `xml:"rdf:about,attr"`
+
+	PrefLabels []*Literal `xml:"skos:prefLabel"`
+
+	AltLabels []*Literal `xml:"skos:altLabel"`
+
+	Notations []*Literal `xml:"skos:notation"`
+}
@@ -0,0 +1,190 @@
+package edm
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/verisart/xsd/lido"
+)
+
+func testRecord() *lido.Lido {
+	l := &lido.Lido{}
+	l.AppendRecID("DE-Mb112", lido.LocalRecordType, "obj00154983")
+
+	desc := l.CreateDesc("it")
+	desc.AppendTermWorkType("AAT", lido.URIType, "http://vocab.getty.edu/aat/300033618", "painting")
+	title := lido.NewTitle("Primavera", "", true, lido.RepositoryTitle)
+	title.Append("Spring", "en", false)
+	desc.ObjectID.TitleWrap.Append(title)
+	desc.ObjectID.InscriptionsWrap = &lido.InscriptionsWrap{Inscriptions: []*lido.Inscription{{}}}
+	desc.ObjectID.RepositoryWrap = &lido.RepositoryWrap{Repositories: []*lido.Repository{{
+		RepositoryName: &lido.LegalBodyRef{LegalBodyNames: []*lido.Appellation{{
+			Values: []*lido.AppellationValue{{Value: "Galleria degli Uffizi"}},
+		}}},
+		WorkIDs: []*lido.WorkID{{XsdtString: "1890 n. 8360"}},
+	}}}
+
+	desc.EventWrap = &lido.EventWrap{}
+	desc.EventWrap.AppendEvent(&lido.Event{
+		EventTypes: []*lido.Concept{lido.NewURIConcept("http://terminology.lido-schema.org/lido00007", "Production", "en")},
+		Date: &lido.DateSet{Date: &lido.DateSpan{
+			EarliestDate: &lido.Date{Value: "1478"},
+			LatestDate:   &lido.Date{Value: "1482"},
+		}},
+		EventActors: []*lido.EventActor{{ActorInRole: lido.ActorInRole{Actor: &lido.Actor{
+			ActorIDs: []*lido.Identifier{{Value: "http://vocab.getty.edu/ulan/500010368", Type: lido.URIType}},
+			NameActorSets: []*lido.Appellation{{Values: []*lido.AppellationValue{
+				{Value: "Botticelli, Sandro", Pref: lido.Preferred},
+				{Value: "Filipepi, Alessandro", Pref: lido.Alternate},
+			}}},
+		}}}},
+		EventPlaces: []*lido.EventPlace{{PlaceSet: lido.PlaceSet{Place: &lido.Place{
+			NamePlaceSets: []*lido.Appellation{{Values: []*lido.AppellationValue{{Value: "Firenze"}}}},
+		}}}},
+		Cultures: []*lido.ConceptElement{{}},
+	})
+
+	l.AdministrativeMetadatas = []*lido.AdministrativeMetadata{{
+		Lang: "en",
+		RightsWorkWrap: &lido.RightsWorkWrap{RightsWorkSets: []*lido.Rights{{
+			RightsTypes: []*lido.Concept{lido.NewURIConcept("http://rightsstatements.org/vocab/NoC-NC/1.0/", "No Copyright", "en")},
+			CreditLines: []*lido.Text{{Value: "Gallerie degli Uffizi"}},
+		}}},
+		ResourceWrap: &lido.ResourceWrap{ResourceSets: []*lido.ResourceSet{{
+			ResourceRepresentations: []*lido.ResourceRep{
+				{LinkResource: &lido.LinkResource{WebResource: lido.WebResource{XsdtString: "http://example.org/primavera.jpg", FormatResource: "image/jpeg"}}},
+				{Type: "image_thumb", LinkResource: &lido.LinkResource{WebResource: lido.WebResource{XsdtString: "http://example.org/primavera-thumb.jpg"}}},
+			},
+		}}},
+	}}
+	return l
+}
+
+func TestFromLido(t *testing.T) {
+	if _, _, err := FromLido(&lido.Lido{}, Options{BaseURI: "http://data.example.org/"}); err == nil {
+		t.Error("record without lidoRecID converted")
+	}
+
+	rdf, unmapped, err := FromLido(testRecord(), Options{BaseURI: "http://data.example.org/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cho := rdf.ProvidedCHOs[0]
+	if cho.About != "http://data.example.org/item/obj00154983" || cho.Type != TypeImage {
+		t.Errorf("unexpected CHO %s of type %s", cho.About, cho.Type)
+	}
+	if !reflect.DeepEqual(cho.Titles, []*Literal{{Value: "Primavera", Lang: "it"}}) {
+		t.Errorf("titles: %+v", cho.Titles)
+	}
+	if !reflect.DeepEqual(cho.Alternatives, []*Literal{{Value: "Spring", Lang: "en"}}) {
+		t.Errorf("alternatives: %+v", cho.Alternatives)
+	}
+	if len(cho.Creators) != 1 || cho.Creators[0].Resource != "http://vocab.getty.edu/ulan/500010368" {
+		t.Errorf("creators: %+v", cho.Creators)
+	}
+	if len(rdf.Agents) != 1 || len(rdf.Agents[0].AltLabels) != 1 {
+		t.Errorf("agents: %+v", rdf.Agents)
+	}
+	if len(cho.Created) != 1 || cho.Created[0].Value != "1478/1482" {
+		t.Errorf("created: %+v", cho.Created)
+	}
+	if len(rdf.Places) != 1 || len(cho.Spatials) != 1 || cho.Spatials[0].Resource != rdf.Places[0].About {
+		t.Errorf("places: %+v, spatial: %+v", rdf.Places, cho.Spatials)
+	}
+	if len(cho.Types) != 1 || len(rdf.Concepts) != 1 || rdf.Concepts[0].About != "http://vocab.getty.edu/aat/300033618" {
+		t.Errorf("types: %+v, concepts: %+v", cho.Types, rdf.Concepts)
+	}
+	if len(cho.Identifiers) != 1 || cho.Identifiers[0].Value != "1890 n. 8360" {
+		t.Errorf("identifiers: %+v", cho.Identifiers)
+	}
+
+	agg := rdf.Aggregations[0]
+	if agg.DataProvider == nil || agg.DataProvider.Value != "Galleria degli Uffizi" {
+		t.Errorf("data provider: %+v", agg.DataProvider)
+	}
+	if agg.Rights == nil || agg.Rights.Resource != "http://rightsstatements.org/vocab/NoC-NC/1.0/" {
+		t.Errorf("rights: %+v", agg.Rights)
+	}
+	if agg.IsShownBy == nil || agg.IsShownBy.Resource != "http://example.org/primavera.jpg" ||
+		agg.Object == nil || agg.Object.Resource != "http://example.org/primavera-thumb.jpg" {
+		t.Errorf("isShownBy %+v, object %+v", agg.IsShownBy, agg.Object)
+	}
+	if len(rdf.WebResources) != 2 || rdf.WebResources[0].Formats[0].Value != "image/jpeg" {
+		t.Errorf("web resources: %+v", rdf.WebResources)
+	}
+
+	want := []string{
+		"descriptiveMetadata[0]/objectIdentificationWrap/inscriptionsWrap",
+		"descriptiveMetadata[0]/eventWrap/eventSet[0]/event/culture",
+	}
+	if !reflect.DeepEqual(unmapped, want) {
+		t.Errorf("unmapped: got %q, want %q", unmapped, want)
+	}
+
+	var buf bytes.Buffer
+	if err := rdf.Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, s := range []string{
+		`<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"`,
+		`<edm:ProvidedCHO rdf:about="http://data.example.org/item/obj00154983">`,
+		`<dc:title xml:lang="it">Primavera</dc:title>`,
+		`<dc:creator rdf:resource="http://vocab.getty.edu/ulan/500010368"></dc:creator>`,
+		`<edm:type>IMAGE</edm:type>`,
+		`<edm:aggregatedCHO rdf:resource="http://data.example.org/item/obj00154983"></edm:aggregatedCHO>`,
+		`<skos:prefLabel xml:lang="it">Botticelli, Sandro</skos:prefLabel>`,
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("output lacks %s:\n%s", s, out)
+		}
+	}
+}
+
+func TestUnmapped(t *testing.T) {
+	if _, _, err := FromLido(nil, Options{BaseURI: "http://data.example.org/"}); err == nil {
+		t.Error("nil record converted")
+	}
+
+	l := testRecord()
+	set := l.DescriptiveMetadatas[0].EventWrap.Events[0]
+	set.DisplayEvents = []*lido.Text{{Value: "Herstellung"}}
+	event := set.Event
+	event.EventIDs = []*lido.Identifier{{Value: "ev-1"}}
+	event.EventActors[0].RoleActors = []*lido.ConceptElement{{Concept: *lido.NewURIConcept("http://vocab.getty.edu/aat/300025136", "painter", "en")}}
+	event.EventActors[0].AttributionQualifierActors = []*lido.Text{{Value: "attributed to"}}
+	event.EventActors = append(event.EventActors, &lido.EventActor{ActorInRole: lido.ActorInRole{ExtentActors: []*lido.Text{{Value: "figures"}}}})
+	rights := l.AdministrativeMetadatas[0].RightsWorkWrap
+	rights.RightsWorkSets[0].RightsDate = lido.NewDateSpan("1482", "")
+	rights.RightsWorkSets = append(rights.RightsWorkSets, &lido.Rights{
+		RightsTypes: []*lido.Concept{
+			lido.NewURIConcept("http://rightsstatements.org/vocab/NoC-NC/1.0/", "No Copyright", "en"),
+			lido.NewURIConcept("http://creativecommons.org/publicdomain/mark/1.0/", "Public Domain Mark", "en"),
+		},
+	})
+
+	rdf, unmapped, err := FromLido(l, Options{BaseURI: "http://data.example.org/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rdf.Aggregations[0].Rights.Resource != "http://rightsstatements.org/vocab/NoC-NC/1.0/" {
+		t.Errorf("rights: %+v", rdf.Aggregations[0].Rights)
+	}
+	want := []string{
+		"descriptiveMetadata[0]/objectIdentificationWrap/inscriptionsWrap",
+		"descriptiveMetadata[0]/eventWrap/eventSet[0]/displayEvent",
+		"descriptiveMetadata[0]/eventWrap/eventSet[0]/event/eventID",
+		"descriptiveMetadata[0]/eventWrap/eventSet[0]/event/eventActor[0]/roleActor",
+		"descriptiveMetadata[0]/eventWrap/eventSet[0]/event/eventActor[0]/attributionQualifierActor",
+		"descriptiveMetadata[0]/eventWrap/eventSet[0]/event/eventActor[1]",
+		"descriptiveMetadata[0]/eventWrap/eventSet[0]/event/culture",
+		"administrativeMetadata[0]/rightsWorkWrap/rightsWorkSet[0]/rightsDate",
+		"administrativeMetadata[0]/rightsWorkWrap/rightsWorkSet[1]/rightsType[1]",
+	}
+	if !reflect.DeepEqual(unmapped, want) {
+		t.Errorf("unmapped: got %q, want %q", unmapped, want)
+	}
+}
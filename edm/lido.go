@@ -0,0 +1,688 @@
+package edm
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/verisart/xsd/lido"
+)
+
+// Controls the conversion of a LIDO record.
+type Options struct {
+	// The prefix of the URIs minted for the object, the aggregation and
+	// contextual resources without a URI of their own, e.g.
+	// "http://data.example.org/". Required.
+	BaseURI string
+
+	// The name or URI of the institution providing the data. Defaults to the
+	// name of the first repository of the object.
+	DataProvider string
+
+	// The name or URI of the aggregator submitting the data, if any.
+	Provider string
+
+	// The edm:type of the object. Derived from the MIME type of the first
+	// linked resource if empty, defaulting to TypeImage.
+	Type string
+}
+
+// Converts a LIDO record to EDM. The object becomes an edm:ProvidedCHO with
+// an ore:Aggregation, linked resources become edm:WebResources, and actors,
+// places and concepts with URIs become contextual resources. The returned
+// paths name the LIDO elements holding data that EDM has no place for, in
+// the form used by lido.ValidationError, e.g.
+// "descriptiveMetadata[0]/objectIdentificationWrap/inscriptionsWrap".
+//
+// The object is identified by the first lidoRecID, so the record must have
+// one.
+//
+// Mapping:
+//   - titleSet: dc:title, or dcterms:alternative for alternate values and
+//     titles of type lido.AlternateTitle
+//   - objectWorkType, classification: dc:type
+//   - objectDescriptionSet: dc:description
+//   - objectPublishedID, workID: dc:identifier
+//   - repositoryName: edm:dataProvider, unless Options.DataProvider is set
+//   - repositoryLocation: edm:currentLocation
+//   - objectMeasurementsSet: dcterms:extent
+//   - actors of production and creation events: dc:creator; of other
+//     events: dc:contributor
+//   - eventDate of production and creation events: dcterms:created
+//   - eventPlace: dcterms:spatial
+//   - eventMaterialsTech: dcterms:medium
+//   - periodName: dcterms:temporal
+//   - subjectConcept, subjectActor, displaySubject: dc:subject;
+//     subjectPlace: dcterms:spatial; subjectDate: dcterms:temporal
+//   - rightsWorkSet: the first rightsType URI to edm:rights of the
+//     aggregation, credit lines and holders to dc:rights
+//   - linkResource: edm:isShownBy for the first resource, edm:object for
+//     thumbnails, edm:hasView for the others
+//   - rightsResource: edm:rights and dc:rights of the web resource
+//   - recordInfoLink: edm:isShownAt
+func FromLido(l *lido.Lido, opts Options) (*RDF, []string, error) {
+	if opts.BaseURI == "" {
+		return nil, nil, errors.New("edm: Options.BaseURI is required")
+	}
+	if l == nil {
+		return nil, nil, errors.New("edm: missing lido record")
+	}
+	if len(l.LidoRecIDs) == 0 || l.LidoRecIDs[0] == nil || l.LidoRecIDs[0].Value == "" {
+		return nil, nil, errors.New("edm: record has no lidoRecID")
+	}
+	id := url.PathEscape(strings.TrimSpace(string(l.LidoRecIDs[0].Value)))
+
+	c := &converter{
+		opts:  opts,
+		id:    id,
+		rdf:   NewRDF(),
+		cho:   &ProvidedCHO{About: opts.BaseURI + "item/" + id},
+		agg:   &Aggregation{About: opts.BaseURI + "aggregation/" + id},
+		seen:  map[string]bool{},
+		langs: lido.NewLangResolver(l),
+	}
+	c.agg.AggregatedCHO.Resource = c.cho.About
+	c.rdf.ProvidedCHOs = []*ProvidedCHO{c.cho}
+	c.rdf.Aggregations = []*Aggregation{c.agg}
+
+	for i, oid := range l.LidoRecIDs[1:] {
+		c.unmapped(lido.IndexPath("", "lidoRecID", i+1), oid != nil && oid.Value != "")
+	}
+	for _, oid := range l.ObjectPublishedIDs {
+		if oid != nil && oid.Value != "" {
+			c.cho.Identifiers = append(c.cho.Identifiers, &Literal{Value: string(oid.Value)})
+		}
+	}
+	c.unmapped("category", l.Category != nil)
+	for i, dm := range l.DescriptiveMetadatas {
+		if dm != nil {
+			c.descriptive(lido.IndexPath("", "descriptiveMetadata", i), dm)
+		}
+	}
+	for i, am := range l.AdministrativeMetadatas {
+		if am != nil {
+			c.administrative(lido.IndexPath("", "administrativeMetadata", i), am)
+		}
+	}
+
+	if opts.DataProvider != "" {
+		c.agg.DataProvider = literalOrResource(opts.DataProvider)
+	}
+	if opts.Provider != "" {
+		c.agg.Provider = literalOrResource(opts.Provider)
+	}
+	c.cho.Type = opts.Type
+	if c.cho.Type == "" {
+		c.cho.Type = typeOf(c.firstFormat)
+	}
+	return c.rdf, c.missing, nil
+}
+
+type converter struct {
+	opts        Options
+	id          string
+	rdf         *RDF
+	cho         *ProvidedCHO
+	agg         *Aggregation
+	seen        map[string]bool
+	minted      int
+	firstFormat string
+	missing     []string
+	langs       *lido.LangResolver
+}
+
+// Records path as unmapped if present is set.
+func (c *converter) unmapped(path string, present bool) {
+	if present {
+		c.missing = append(c.missing, path)
+	}
+}
+
+// Returns a new URI for a contextual resource of the specified kind.
+func (c *converter) mint(kind string) string {
+	c.minted++
+	return c.opts.BaseURI + kind + "/" + c.id + "-" + strconv.Itoa(c.minted)
+}
+
+func literalOrResource(s string) *LiteralOrResource {
+	if lido.IsURI(s) {
+		return &LiteralOrResource{Resource: s}
+	}
+	return &LiteralOrResource{Value: s}
+}
+
+func (c *converter) texts(texts []*lido.Text) (literals []*Literal) {
+	for _, t := range texts {
+		if t != nil && strings.TrimSpace(string(t.Value)) != "" {
+			literals = append(literals, &Literal{Value: string(t.Value), Lang: c.langs.Tag(t)})
+		}
+	}
+	return
+}
+
+func asValues(literals []*Literal) (values []*LiteralOrResource) {
+	for _, l := range literals {
+		values = append(values, &LiteralOrResource{Value: l.Value, Lang: l.Lang})
+	}
+	return
+}
+
+// Returns the preferred and alternate values of an appellation as labels.
+func (c *converter) labels(a *lido.Appellation) (pref, alt []*Literal) {
+	if a == nil {
+		return
+	}
+	for _, v := range a.Values {
+		if v == nil || v.Value == "" {
+			continue
+		}
+		l := &Literal{Value: string(v.Value), Lang: c.langs.Tag(v)}
+		if v.Pref == lido.Alternate {
+			alt = append(alt, l)
+		} else {
+			pref = append(pref, l)
+		}
+	}
+	return
+}
+
+// Returns a concept as a reference to a skos:Concept if it has a URI, and
+// otherwise as the literals of its terms. Added search terms are left out.
+func (c *converter) concept(concept *lido.Concept) []*LiteralOrResource {
+	if concept == nil {
+		return nil
+	}
+	var pref, alt []*Literal
+	for _, t := range concept.Terms {
+		if t == nil || t.Value == "" || t.AddedSearchTerm == "yes" {
+			continue
+		}
+		l := &Literal{Value: string(t.Value), Lang: c.langs.Tag(t)}
+		if t.Pref == lido.Alternate {
+			alt = append(alt, l)
+		} else {
+			pref = append(pref, l)
+		}
+	}
+	uri := lido.URIOf(concept.ConceptIDs)
+	if uri == "" {
+		return asValues(append(pref, alt...))
+	}
+	if !c.seen[uri] {
+		c.seen[uri] = true
+		c.rdf.Concepts = append(c.rdf.Concepts, &Concept{About: uri, PrefLabels: pref, AltLabels: alt})
+	}
+	return []*LiteralOrResource{{Resource: uri}}
+}
+
+func (c *converter) agent(actor *lido.Actor) []*LiteralOrResource {
+	if actor == nil {
+		return nil
+	}
+	uri := lido.URIOf(actor.ActorIDs)
+	if uri == "" {
+		uri = c.mint("agent")
+	}
+	if !c.seen[uri] {
+		c.seen[uri] = true
+		agent := &Agent{About: uri}
+		for _, name := range actor.NameActorSets {
+			pref, alt := c.labels(name)
+			agent.PrefLabels = append(agent.PrefLabels, pref...)
+			agent.AltLabels = append(agent.AltLabels, alt...)
+		}
+		if span := actor.VitalDatesActor; span != nil {
+			if span.EarliestDate != nil && span.EarliestDate.Value != "" {
+				agent.DateOfBirth = []*Literal{{Value: string(span.EarliestDate.Value)}}
+			}
+			if span.LatestDate != nil && span.LatestDate.Value != "" {
+				agent.DateOfDeath = []*Literal{{Value: string(span.LatestDate.Value)}}
+			}
+		}
+		agent.Gender = c.texts(actor.GenderActors)
+		c.rdf.Agents = append(c.rdf.Agents, agent)
+	}
+	return []*LiteralOrResource{{Resource: uri}}
+}
+
+func (c *converter) place(place *lido.Place) string {
+	uri := lido.URIOf(place.PlaceIDs)
+	if uri == "" {
+		uri = c.mint("place")
+	}
+	if c.seen[uri] {
+		return uri
+	}
+	c.seen[uri] = true
+	p := &Place{About: uri}
+	c.rdf.Places = append(c.rdf.Places, p)
+	for _, name := range place.NamePlaceSets {
+		pref, alt := c.labels(name)
+		p.PrefLabels = append(p.PrefLabels, pref...)
+		p.AltLabels = append(p.AltLabels, alt...)
+	}
+	for _, g := range place.GMLs {
+		if g == nil {
+			continue
+		}
+		for _, point := range g.Points {
+			if point == nil || point.Pos == nil || p.Lat != "" {
+				continue
+			}
+			if pos := strings.Fields(string(point.Pos.DoubleList)); len(pos) >= 2 {
+				p.Lat, p.Long = pos[0], pos[1]
+			}
+		}
+	}
+	for _, part := range place.PartOfPlaces {
+		if part != nil {
+			p.IsPartOf = append(p.IsPartOf, &Resource{Resource: c.place(part)})
+		}
+	}
+	return uri
+}
+
+// Returns a place set as a reference to an edm:Place, or as its display
+// texts if it has no structured place.
+func (c *converter) placeSet(set *lido.PlaceSet) []*LiteralOrResource {
+	if set == nil {
+		return nil
+	}
+	if set.Place == nil {
+		return asValues(c.texts(set.DisplayPlaces))
+	}
+	return []*LiteralOrResource{{Resource: c.place(set.Place)}}
+}
+
+// Returns the display dates of a date set, or its span as an ISO 8601 date
+// or interval.
+func (c *converter) dates(set *lido.DateSet) []*Literal {
+	if set == nil {
+		return nil
+	}
+	if literals := c.texts(set.DisplayDates); len(literals) > 0 {
+		return literals
+	}
+	if set.Date == nil || set.Date.String() == "" {
+		return nil
+	}
+	return []*Literal{{Value: set.Date.String()}}
+}
+
+func (c *converter) descriptive(path string, dm *lido.DescriptiveMetadata) {
+	lang := string(dm.Lang)
+	if lang != "" {
+		found := false
+		for _, l := range c.cho.Languages {
+			found = found || l.Value == lang
+		}
+		if !found {
+			c.cho.Languages = append(c.cho.Languages, &Literal{Value: lang})
+		}
+	}
+
+	for _, t := range dm.ObjectClass.WorkType.Types {
+		if t != nil {
+			c.cho.Types = append(c.cho.Types, c.concept(&t.Concept)...)
+		}
+	}
+	if dm.ObjectClass.ClassificationWrap != nil {
+		for _, t := range dm.ObjectClass.ClassificationWrap.Classifications {
+			if t != nil {
+				c.cho.Types = append(c.cho.Types, c.concept(&t.Concept)...)
+			}
+		}
+	}
+
+	p := lido.JoinPath(path, "objectIdentificationWrap")
+	id := &dm.ObjectID
+	for _, title := range id.TitleWrap.Titles {
+		if title == nil {
+			continue
+		}
+		pref, alt := c.labels(&title.Appellation)
+		if string(title.Type) == lido.AlternateTitle {
+			alt, pref = append(pref, alt...), nil
+		}
+		c.cho.Titles = append(c.cho.Titles, pref...)
+		c.cho.Alternatives = append(c.cho.Alternatives, alt...)
+	}
+	c.unmapped(lido.JoinPath(p, "inscriptionsWrap"), id.InscriptionsWrap != nil && len(id.InscriptionsWrap.Inscriptions) > 0)
+	if id.RepositoryWrap != nil {
+		for i, r := range id.RepositoryWrap.Repositories {
+			if r == nil {
+				continue
+			}
+			q := lido.IndexPath(lido.JoinPath(p, "repositoryWrap"), "repositorySet", i)
+			if r.RepositoryName != nil && c.agg.DataProvider == nil {
+				if uri := lido.URIOf(r.RepositoryName.LegalBodyIDs); uri != "" {
+					c.agg.DataProvider = &LiteralOrResource{Resource: uri}
+				} else {
+					for _, name := range r.RepositoryName.LegalBodyNames {
+						if pref, _ := c.labels(name); len(pref) > 0 {
+							c.agg.DataProvider = &LiteralOrResource{Value: pref[0].Value, Lang: pref[0].Lang}
+							break
+						}
+					}
+				}
+			}
+			for _, w := range r.WorkIDs {
+				if w != nil && w.XsdtString != "" {
+					c.cho.Identifiers = append(c.cho.Identifiers, &Literal{Value: string(w.XsdtString)})
+				}
+			}
+			if r.RepositoryLocation != nil {
+				if c.cho.CurrentLocation == nil {
+					c.cho.CurrentLocation = &Resource{Resource: c.place(r.RepositoryLocation)}
+				} else {
+					c.unmapped(lido.JoinPath(q, "repositoryLocation"), true)
+				}
+			}
+		}
+	}
+	c.unmapped(lido.JoinPath(p, "displayStateEditionWrap"), id.DisplayStateEditionWrap != nil)
+	if id.Description != nil {
+		for _, note := range id.Description.Notes {
+			if note != nil {
+				c.cho.Descriptions = append(c.cho.Descriptions, c.texts(note.Values)...)
+			}
+		}
+	}
+	if id.MeasurementsWrap != nil {
+		for _, set := range id.MeasurementsWrap.MeasurementsSets {
+			if set != nil {
+				c.cho.Extents = append(c.cho.Extents, c.measurements(set)...)
+			}
+		}
+	}
+
+	if dm.EventWrap != nil {
+		for i, e := range dm.EventWrap.Events {
+			if e == nil {
+				continue
+			}
+			q := lido.IndexPath(lido.JoinPath(path, "eventWrap"), "eventSet", i)
+			c.unmapped(lido.JoinPath(q, "displayEvent"), len(e.DisplayEvents) > 0)
+			if e.Event != nil {
+				c.event(lido.JoinPath(q, "event"), e.Event)
+			}
+		}
+	}
+
+	if rel := dm.ObjectRelationWrap; rel != nil {
+		p = lido.JoinPath(path, "objectRelationWrap")
+		if rel.SubjectWrap != nil {
+			for i, set := range rel.SubjectWrap.SubjectSets {
+				if set != nil {
+					c.subject(lido.IndexPath(lido.JoinPath(p, "subjectWrap"), "subjectSet", i), set)
+				}
+			}
+		}
+		c.unmapped(lido.JoinPath(p, "relatedWorksWrap"), rel.RelatedWorksWrap != nil && len(rel.RelatedWorksWrap.RelatedWorkSets) > 0)
+	}
+}
+
+// Returns the display measurements of a set, or its structured measurements
+// as "type: value unit".
+func (c *converter) measurements(set *lido.MeasurementsSet) []*Literal {
+	if literals := c.texts(set.DisplayMeasurements); len(literals) > 0 || set.Measurements == nil {
+		return literals
+	}
+	var literals []*Literal
+	for _, m := range set.Measurements.MeasurementsSets {
+		if m == nil || m.Value.Value == "" {
+			continue
+		}
+		s := string(m.Value.Value)
+		if len(m.Units) > 0 && m.Units[0] != nil {
+			s += " " + string(m.Units[0].Value)
+		}
+		if len(m.Types) > 0 && m.Types[0] != nil {
+			s = string(m.Types[0].Value) + ": " + s
+		}
+		literals = append(literals, &Literal{Value: s})
+	}
+	return literals
+}
+
+func (c *converter) event(path string, event *lido.Event) {
+	creation := event.IsCreation()
+	c.unmapped(lido.JoinPath(path, "eventID"), len(event.EventIDs) > 0)
+	for i, actor := range event.EventActors {
+		if actor == nil {
+			continue
+		}
+		p := lido.IndexPath(path, "eventActor", i)
+		if actor.Actor == nil {
+			c.unmapped(p, true)
+			continue
+		}
+		c.unmapped(lido.JoinPath(p, "roleActor"), len(actor.RoleActors) > 0)
+		c.unmapped(lido.JoinPath(p, "attributionQualifierActor"), len(actor.AttributionQualifierActors) > 0)
+		c.unmapped(lido.JoinPath(p, "extentActor"), len(actor.ExtentActors) > 0)
+		ref := c.agent(actor.Actor)
+		if creation {
+			c.cho.Creators = append(c.cho.Creators, ref...)
+		} else {
+			c.cho.Contributors = append(c.cho.Contributors, ref...)
+		}
+	}
+	if creation {
+		c.cho.Created = append(c.cho.Created, c.dates(event.Date)...)
+	} else {
+		c.unmapped(lido.JoinPath(path, "eventDate"), event.Date != nil)
+	}
+	for _, place := range event.EventPlaces {
+		if place != nil {
+			c.cho.Spatials = append(c.cho.Spatials, c.placeSet(&place.PlaceSet)...)
+		}
+	}
+	for _, mt := range event.EventMaterialsTechs {
+		if mt == nil {
+			continue
+		}
+		if mt.MaterialsTech == nil || len(mt.MaterialsTech.TermMaterialsTechs) == 0 {
+			c.cho.Mediums = append(c.cho.Mediums, asValues(c.texts(mt.DisplayMaterialsTechs))...)
+			continue
+		}
+		for _, term := range mt.MaterialsTech.TermMaterialsTechs {
+			if term != nil {
+				c.cho.Mediums = append(c.cho.Mediums, c.concept(&term.Concept)...)
+			}
+		}
+	}
+	for _, period := range event.PeriodNames {
+		if period != nil {
+			c.cho.Temporals = append(c.cho.Temporals, c.concept(&period.Concept)...)
+		}
+	}
+	c.unmapped(lido.JoinPath(path, "eventName"), len(event.EventNames) > 0)
+	c.unmapped(lido.JoinPath(path, "eventMethod"), len(event.EventMethods) > 0)
+	c.unmapped(lido.JoinPath(path, "thingPresent"), len(event.ThingPresents) > 0)
+	c.unmapped(lido.JoinPath(path, "eventDescriptionSet"), len(event.EventDescriptionSets) > 0)
+	c.unmapped(lido.JoinPath(path, "relatedEventSet"), len(event.RelatedEvents) > 0)
+	c.unmapped(lido.JoinPath(path, "roleInEvent"), len(event.RoleInEvents) > 0)
+	c.unmapped(lido.JoinPath(path, "culture"), len(event.Cultures) > 0)
+}
+
+func (c *converter) subject(path string, set *lido.SubjectSet) {
+	s := set.Subject
+	if s == nil {
+		c.cho.Subjects = append(c.cho.Subjects, asValues(c.texts(set.DisplaySubjects))...)
+		return
+	}
+	p := lido.JoinPath(path, "subject")
+	for _, concept := range s.SubjectConcepts {
+		if concept != nil {
+			c.cho.Subjects = append(c.cho.Subjects, c.concept(&concept.Concept)...)
+		}
+	}
+	for _, actor := range s.SubjectActors {
+		if actor != nil {
+			c.cho.Subjects = append(c.cho.Subjects, c.agent(actor.Actor)...)
+		}
+	}
+	for _, place := range s.SubjectPlaces {
+		c.cho.Spatials = append(c.cho.Spatials, c.placeSet(place)...)
+	}
+	for _, date := range s.SubjectDates {
+		if date != nil && date.String() != "" {
+			c.cho.Temporals = append(c.cho.Temporals, &LiteralOrResource{Value: date.String()})
+		}
+	}
+	c.unmapped(lido.JoinPath(p, "subjectObject"), len(s.SubjectObjects) > 0)
+	c.unmapped(lido.JoinPath(p, "subjectEvent"), len(s.SubjectEvents) > 0)
+	c.unmapped(lido.JoinPath(p, "extentSubject"), len(s.ExtentSubjects) > 0)
+}
+
+// Returns the textual rights of a rights element, and sets statement to its
+// first rights statement URI unless it is already set. Other rights statement
+// URIs are reported as unmapped.
+func (c *converter) rights(path string, r *lido.Rights, statement *string) (values []*LiteralOrResource) {
+	for i, t := range r.RightsTypes {
+		if t == nil {
+			continue
+		}
+		switch uri := lido.URIOf(t.ConceptIDs); {
+		case uri == "":
+			values = append(values, c.concept(t)...)
+		case *statement == "":
+			*statement = uri
+		default:
+			c.unmapped(lido.IndexPath(path, "rightsType", i), uri != *statement)
+		}
+	}
+	c.unmapped(lido.JoinPath(path, "rightsDate"), r.RightsDate != nil)
+	for _, holder := range r.RightsHolders {
+		if holder == nil {
+			continue
+		}
+		for _, name := range holder.LegalBodyNames {
+			pref, _ := c.labels(name)
+			values = append(values, asValues(pref)...)
+		}
+	}
+	values = append(values, asValues(c.texts(r.CreditLines))...)
+	return
+}
+
+func (c *converter) administrative(path string, am *lido.AdministrativeMetadata) {
+	if am.RightsWorkWrap != nil {
+		var statement string
+		if c.agg.Rights != nil {
+			statement = c.agg.Rights.Resource
+		}
+		for i, r := range am.RightsWorkWrap.RightsWorkSets {
+			if r != nil {
+				q := lido.IndexPath(lido.JoinPath(path, "rightsWorkWrap"), "rightsWorkSet", i)
+				c.cho.Rights = append(c.cho.Rights, c.rights(q, r, &statement)...)
+			}
+		}
+		if statement != "" && c.agg.Rights == nil {
+			c.agg.Rights = &Resource{Resource: statement}
+		}
+	}
+
+	if rw := am.RecordWrap; rw != nil {
+		p := lido.JoinPath(path, "recordWrap")
+		c.unmapped(lido.JoinPath(p, "recordID"), len(rw.RecordIDs) > 0)
+		c.unmapped(lido.JoinPath(p, "recordType"), rw.RecordType != nil)
+		c.unmapped(lido.JoinPath(p, "recordSource"), len(rw.RecordSources) > 0)
+		c.unmapped(lido.JoinPath(p, "recordRights"), len(rw.RecordRights) > 0)
+		for i, info := range rw.RecordInfoSets {
+			if info == nil {
+				continue
+			}
+			q := lido.IndexPath(p, "recordInfoSet", i)
+			for _, link := range info.RecordInfoLinks {
+				if link != nil && link.XsdtString != "" && c.agg.IsShownAt == nil {
+					c.agg.IsShownAt = &Resource{Resource: strings.TrimSpace(string(link.XsdtString))}
+				}
+			}
+			c.unmapped(lido.JoinPath(q, "recordInfoID"), len(info.RecordInfoIDs) > 0)
+			c.unmapped(lido.JoinPath(q, "recordMetadataDate"), len(info.RecordMetadataDates) > 0)
+		}
+	}
+
+	if am.ResourceWrap != nil {
+		for i, set := range am.ResourceWrap.ResourceSets {
+			if set != nil {
+				c.resource(lido.IndexPath(lido.JoinPath(path, "resourceWrap"), "resourceSet", i), set)
+			}
+		}
+	}
+}
+
+func (c *converter) resource(path string, set *lido.ResourceSet) {
+	var statement string
+	var rights []*LiteralOrResource
+	for i, r := range set.RightsResources {
+		if r != nil {
+			rights = append(rights, c.rights(lido.IndexPath(path, "rightsResource", i), r, &statement)...)
+		}
+	}
+	var descriptions []*Literal
+	for _, note := range set.ResourceDescriptions {
+		if note != nil && note.Value != "" {
+			descriptions = append(descriptions, &Literal{Value: string(note.Value), Lang: c.langs.Tag(&note.Text)})
+		}
+	}
+
+	linked := false
+	for _, rep := range set.ResourceRepresentations {
+		if rep == nil || rep.LinkResource == nil || strings.TrimSpace(string(rep.LinkResource.XsdtString)) == "" {
+			continue
+		}
+		linked = true
+		link := rep.LinkResource
+		uri := strings.TrimSpace(string(link.XsdtString))
+		format := string(link.FormatResource)
+		if c.firstFormat == "" {
+			c.firstFormat = format
+		}
+		if !c.seen[uri] {
+			c.seen[uri] = true
+			wr := &WebResource{About: uri, Descriptions: descriptions, Rights: rights}
+			if format != "" {
+				wr.Formats = []*Literal{{Value: format}}
+			}
+			if statement != "" {
+				wr.EDMRights = &Resource{Resource: statement}
+			}
+			c.rdf.WebResources = append(c.rdf.WebResources, wr)
+		}
+		switch {
+		case strings.Contains(strings.ToLower(string(rep.Type)), "thumb"):
+			if c.agg.Object == nil {
+				c.agg.Object = &Resource{Resource: uri}
+			}
+		case c.agg.IsShownBy == nil:
+			c.agg.IsShownBy = &Resource{Resource: uri}
+		default:
+			c.agg.HasViews = append(c.agg.HasViews, &Resource{Resource: uri})
+		}
+	}
+	c.unmapped(lido.JoinPath(path, "resourceRepresentation"), !linked && len(set.ResourceRepresentations) > 0)
+	c.unmapped(lido.JoinPath(path, "resourceID"), set.ResourceID != nil)
+	c.unmapped(lido.JoinPath(path, "resourceType"), set.ResourceType != nil)
+	c.unmapped(lido.JoinPath(path, "resourceRelType"), len(set.ResourceRelTypes) > 0)
+	c.unmapped(lido.JoinPath(path, "resourcePerspective"), len(set.ResourcePerspectives) > 0)
+	c.unmapped(lido.JoinPath(path, "resourceDateTaken"), set.ResourceDateTaken != nil)
+	c.unmapped(lido.JoinPath(path, "resourceSource"), len(set.ResourceSources) > 0)
+}
+
+// Returns the edm:type matching a MIME type.
+func typeOf(format string) string {
+	switch {
+	case strings.HasPrefix(format, "audio/"):
+		return TypeSound
+	case strings.HasPrefix(format, "video/"):
+		return TypeVideo
+	case strings.HasPrefix(format, "model/"):
+		return Type3D
+	case strings.HasPrefix(format, "text/"), format == "application/pdf":
+		return TypeText
+	}
+	return TypeImage
+}
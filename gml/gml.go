@@ -17,7 +17,7 @@ type LineString struct {
 	//  another point defined outside of this curve (reuse of existing points). 2. The "posList" element allows for a compact way to
 	//  specifiy the coordinates of the control points, if all control points are in the same coordinate reference systems and belong
 	//  to this curve only. The number of direct positions in the list must be at least two.
-	PosList *DirectPosition `xml:"http://www.opengis.net/gml posList"`
+	PosList *DirectPosition `xml:"http://www.opengis.net/gml posList" json:"posList,omitempty"`
 
 	//  GML supports two different ways to specify the control points of a line string. 1. A sequence of "pos"
 	//  (DirectPositionType) or "pointProperty" (PointPropertyType) elements. "pos" elements are control points that are only part
@@ -26,19 +26,19 @@ type LineString struct {
 	//  specifiy the coordinates of the control points, if all control points are in the same coordinate reference systems and belong
 	//  to this curve only. The number of direct positions in the list must be at least two.
 	//  Deprecated with GML version 3.1.0. Use "posList" instead.
-	Coordinates *Coordinates `xml:"http://www.opengis.net/gml coordinates"`
+	Coordinates *Coordinates `xml:"http://www.opengis.net/gml coordinates" json:"coordinates,omitempty"`
 
-	Poses []*DirectPosition `xml:"http://www.opengis.net/gml pos"`
+	Poses []*DirectPosition `xml:"http://www.opengis.net/gml pos" json:"pos,omitempty"`
 
-	PointProperties []*PointProperty `xml:"http://www.opengis.net/gml pointProperty"`
+	PointProperties []*PointProperty `xml:"http://www.opengis.net/gml pointProperty" json:"pointProperty,omitempty"`
 
 	//  Deprecated with GML version 3.1.0. Use "pointProperty" instead. Included for backwards compatibility
 	//  with GML 3.0.0.
-	PointReps []*PointProperty `xml:"http://www.opengis.net/gml pointRep"`
+	PointReps []*PointProperty `xml:"http://www.opengis.net/gml pointRep" json:"pointRep,omitempty"`
 
 	//  Deprecated with GML version 3.0. Use "pos" instead. The "coord" element is included for backwards
 	//  compatibility with GML 2.
-	Coord *Coord `xml:"http://www.opengis.net/gml coord"`
+	Coord *Coord `xml:"http://www.opengis.net/gml coord" json:"coord,omitempty"`
 }
 
 // A Polygon is a special surface that is defined by a single surface patch. The
@@ -56,20 +56,20 @@ type Polygon struct {
 type Point struct {
 	//  GML supports two different ways to specify the direct poisiton of a point.
 	// 1. The "pos" element is of type DirectPositionType.
-	Pos *DirectPosition `xml:"http://www.opengis.net/gml pos"`
+	Pos *DirectPosition `xml:"http://www.opengis.net/gml pos" json:"pos,omitempty"`
 
 	//  GML supports two different ways to specify the direct poisiton of a point. 1. The "pos" element is of type
 	//  DirectPositionType.
 	//  Deprecated with GML version 3.1.0 for coordinates with ordinate values that are numbers. Use "pos"
 	//  instead. The "coordinates" element shall only be used for coordinates with ordinates that require a string
 	//  representation, e.g. DMS representations.
-	Coordinates *Coordinates `xml:"http://www.opengis.net/gml coordinates"`
+	Coordinates *Coordinates `xml:"http://www.opengis.net/gml coordinates" json:"coordinates,omitempty"`
 
 	// GML supports two different ways to specify the direct poisiton of a point.
 	// 1. The "pos" element is of type DirectPositionType.
 	// Deprecated with GML version 3.0. Use "pos" instead. The "coord" element is
 	// included for backwards compatibility with GML 2.
-	Coord *Coord `xml:"http://www.opengis.net/gml coord"`
+	Coord *Coord `xml:"http://www.opengis.net/gml coord" json:"coord,omitempty"`
 
 	AbstractGeometry
 }
@@ -82,53 +82,53 @@ type AbstractGeometry struct {
 	//  This attribute is included for backward compatibility with GML 2 and is deprecated with GML 3.
 	//  This identifer is superceded by "gml:id" inherited from AbstractGMLType. The attribute "gid" should not be used
 	//  anymore and may be deleted in future versions of GML without further notice.
-	GID xsdt.String `xml:"http://www.opengis.net/gml gid,attr"`
+	GID xsdt.String `xml:"http://www.opengis.net/gml gid,attr" json:"gid,omitempty"`
 }
 
 type AbstractGML struct {
-	ID xsdt.String `xml:"http://www.opengis.net/gml id,attr"`
+	ID xsdt.String `xml:"http://www.opengis.net/gml id,attr" json:"id,omitempty"`
 
 	StandardObjectProperties
 }
 
 type StandardObjectProperties struct {
-	MetaDataProperties []*MetaDataProperty `xml:"http://www.opengis.net/gml metaDataProperty"`
+	MetaDataProperties []*MetaDataProperty `xml:"http://www.opengis.net/gml metaDataProperty" json:"metaDataProperty,omitempty"`
 
-	Description *StringOrRef `xml:"http://www.opengis.net/gml description"`
+	Description *StringOrRef `xml:"http://www.opengis.net/gml description" json:"description,omitempty"`
 
 	// Label for the object, normally a descriptive name. An object may have
 	// several names, typically assigned by different authorities.  The authority
 	// for a name is indicated by the value of its (optional) codeSpace attribute.
 	// The name may or may not be unique, as determined by the rules of the
 	// organization responsible for the codeSpace.
-	Names []*Code `xml:"http://www.opengis.net/gml name"`
+	Names []*Code `xml:"http://www.opengis.net/gml name" json:"name,omitempty"`
 
 	//  The name by which this coordinate system is identified.
-	CsNames []*Code `xml:"http://www.opengis.net/gml csName"`
+	CsNames []*Code `xml:"http://www.opengis.net/gml csName" json:"csName,omitempty"`
 
 	//  The name by which this reference system is identified.
-	SrsNames []*Code `xml:"http://www.opengis.net/gml srsName"`
+	SrsNames []*Code `xml:"http://www.opengis.net/gml srsName" json:"srsNameElement,omitempty"`
 
 	//  The name by which this operation parameter group is identified.
-	GroupNames []*Code `xml:"http://www.opengis.net/gml groupName"`
+	GroupNames []*Code `xml:"http://www.opengis.net/gml groupName" json:"groupName,omitempty"`
 
 	//  The name by which this datum is identified.
-	DatumNames []*Code `xml:"http://www.opengis.net/gml datumName"`
+	DatumNames []*Code `xml:"http://www.opengis.net/gml datumName" json:"datumName,omitempty"`
 
 	//  The name by which this prime meridian is identified. The meridianName most common value is Greenwich, and that value shall be used when the greenwichLongitude value is zero.
-	MeridianNames []*Code `xml:"http://www.opengis.net/gml meridianName"`
+	MeridianNames []*Code `xml:"http://www.opengis.net/gml meridianName" json:"meridianName,omitempty"`
 
 	//  The name by which this ellipsoid is identified.
-	EllipsoidNames []*Code `xml:"http://www.opengis.net/gml ellipsoidName"`
+	EllipsoidNames []*Code `xml:"http://www.opengis.net/gml ellipsoidName" json:"ellipsoidName,omitempty"`
 
 	//  The name by which this coordinate operation is identified.
-	CoordinateOperationNames []*Code `xml:"http://www.opengis.net/gml coordinateOperationName"`
+	CoordinateOperationNames []*Code `xml:"http://www.opengis.net/gml coordinateOperationName" json:"coordinateOperationName,omitempty"`
 
 	//  The name by which this operation method is identified.
-	MethodNames []*Code `xml:"http://www.opengis.net/gml methodName"`
+	MethodNames []*Code `xml:"http://www.opengis.net/gml methodName" json:"methodName,omitempty"`
 
 	//  The name by which this operation parameter is identified.
-	ParameterNames []*Code `xml:"http://www.opengis.net/gml parameterName"`
+	ParameterNames []*Code `xml:"http://www.opengis.net/gml parameterName" json:"parameterName,omitempty"`
 }
 
 // DirectPositions, as data types, will often be included in larger objects
@@ -142,31 +142,31 @@ type DoubleList xsdt.String
 
 type DirectPosition struct {
 	SRSReferenceGroup
-	DoubleList
+	DoubleList `json:"value,omitempty"`
 }
 
 type SRSReferenceGroup struct {
 	//  Ordered list of labels for all the axes of this CRS. The gml:axisAbbrev value should be used for these axis
 	//  labels, after spaces and forbiddden characters are removed. When the srsName attribute is included, this attribute is optional.
 	//  When the srsName attribute is omitted, this attribute shall also be omitted.
-	AxisLabels TNCNameList `xml:"http://www.opengis.net/gml axisLabels,attr"`
+	AxisLabels TNCNameList `xml:"http://www.opengis.net/gml axisLabels,attr" json:"axisLabels,omitempty"`
 
 	//  Ordered list of unit of measure (uom) labels for all the axes of this CRS. The value of the string in the
 	//  gml:catalogSymbol should be used for this uom labels, after spaces and forbiddden characters are removed. When the
 	//  axisLabels attribute is included, this attribute shall also be included. When the axisLabels attribute is omitted, this attribute
 	//  shall also be omitted.
-	UomLabels TNCNameList `xml:"http://www.opengis.net/gml uomLabels,attr"`
+	UomLabels TNCNameList `xml:"http://www.opengis.net/gml uomLabels,attr" json:"uomLabels,omitempty"`
 
 	//  In general this reference points to a CRS instance of gml:CoordinateReferenceSystemType
 	//  (see coordinateReferenceSystems.xsd). For well known references it is not required that the CRS description exists at the
 	//  location the URI points to. If no srsName attribute is given, the CRS must be specified as part of the larger context this
 	//  geometry element is part of, e.g. a geometric element like point, curve, etc. It is expected that this attribute will be specified
 	//  at the direct position level only in rare cases.
-	SrsName xsdt.AnyURI `xml:"http://www.opengis.net/gml srsName,attr"`
+	SrsName xsdt.AnyURI `xml:"http://www.opengis.net/gml srsName,attr" json:"srsName,omitempty"`
 
 	//  The "srsDimension" is the length of coordinate sequence (the number of entries in the list). This dimension is
 	//  specified by the coordinate reference system. When the srsName attribute is omitted, this attribute shall be omitted.
-	SrsDimension xsdt.PositiveInteger `xml:"http://www.opengis.net/gml srsDimension,attr"`
+	SrsDimension xsdt.PositiveInteger `xml:"http://www.opengis.net/gml srsDimension,attr" json:"srsDimension,omitempty"`
 }
 
 //  Optional reference to the CRS used by this geometry, with optional additional information to simplify use when
@@ -185,31 +185,31 @@ type SRSInformationGroup struct {
 	//  Ordered list of labels for all the axes of this CRS. The gml:axisAbbrev value should be used for these axis
 	//  labels, after spaces and forbiddden characters are removed. When the srsName attribute is included, this attribute is optional.
 	//  When the srsName attribute is omitted, this attribute shall also be omitted.
-	AxisLabels TNCNameList `xml:"http://www.opengis.net/gml axisLabels,attr"`
+	AxisLabels TNCNameList `xml:"http://www.opengis.net/gml axisLabels,attr" json:"axisLabels,omitempty"`
 
 	//  Ordered list of unit of measure (uom) labels for all the axes of this CRS. The value of the string in the
 	//  gml:catalogSymbol should be used for this uom labels, after spaces and forbiddden characters are removed. When the
 	//  axisLabels attribute is included, this attribute shall also be included. When the axisLabels attribute is omitted, this attribute
 	//  shall also be omitted.
-	UomLabels TNCNameList `xml:"http://www.opengis.net/gml uomLabels,attr"`
+	UomLabels TNCNameList `xml:"http://www.opengis.net/gml uomLabels,attr" json:"uomLabels,omitempty"`
 }
 
 type Coordinates struct {
-	XsdtString xsdt.String `xml:",chardata"`
+	XsdtString xsdt.String `xml:",chardata" json:"value,omitempty"`
 
-	Decimal xsdt.String `xml:"http://www.opengis.net/gml decimal,attr"`
+	Decimal xsdt.String `xml:"http://www.opengis.net/gml decimal,attr" json:"decimal,omitempty"`
 
-	Cs xsdt.String `xml:"http://www.opengis.net/gml cs,attr"`
+	Cs xsdt.String `xml:"http://www.opengis.net/gml cs,attr" json:"cs,omitempty"`
 
-	Ts xsdt.String `xml:"http://www.opengis.net/gml ts,attr"`
+	Ts xsdt.String `xml:"http://www.opengis.net/gml ts,attr" json:"ts,omitempty"`
 }
 
 type Coord struct {
-	X xsdt.Decimal `xml:"http://www.opengis.net/gml X"`
+	X *xsdt.Decimal `xml:"http://www.opengis.net/gml X" json:"X,omitempty"`
 
-	Y xsdt.Decimal `xml:"http://www.opengis.net/gml Y"`
+	Y *xsdt.Decimal `xml:"http://www.opengis.net/gml Y,omitempty" json:"Y,omitempty"`
 
-	Z xsdt.Decimal `xml:"http://www.opengis.net/gml Z"`
+	Z *xsdt.Decimal `xml:"http://www.opengis.net/gml Z,omitempty" json:"Z,omitempty"`
 }
 
 //  This type encapsulates various dynamic properties of moving objects
@@ -232,59 +232,59 @@ type Coord struct {
 //  or the dictionary from which it is taken.
 //  A text string with an optional codeSpace attribute.
 type Code struct {
-	XsdtString xsdt.String `xml:",chardata"`
+	XsdtString xsdt.String `xml:",chardata" json:"value,omitempty"`
 
-	CodeSpace xsdt.AnyURI `xml:"http://www.opengis.net/gml codeSpace,attr"`
+	CodeSpace xsdt.AnyURI `xml:"http://www.opengis.net/gml codeSpace,attr" json:"codeSpace,omitempty"`
 }
 
 //  This type is available wherever there is a need for a "text" type property. It is of string type, so the text can be included inline, but the value can also be referenced remotely via xlinks from the AssociationAttributeGroup. If the remote reference is present, then the value obtained by traversing the link should be used, and the string content of the element can be used for an annotation.
 type StringOrRef struct {
-	XsdtString xsdt.String `xml:",chardata"`
+	XsdtString xsdt.String `xml:",chardata" json:"value,omitempty"`
 
 	xlink.SimpleLink
 
 	//  Reference to an XML Schema fragment that specifies the content model of
 	// the propertys value. This is in conformance with the XML Schema Section
 	// 4.14 Referencing Schemas from Elsewhere.
-	RemoteSchema xsdt.AnyURI `xml:"http://www.opengis.net/gml remoteSchema,attr"`
+	RemoteSchema xsdt.AnyURI `xml:"http://www.opengis.net/gml remoteSchema,attr" json:"remoteSchema,omitempty"`
 }
 
 type MetaDataProperty struct {
 	xlink.SimpleLink
 
-	About xsdt.AnyURI `xml:"http://www.opengis.net/gml about,attr"`
+	About xsdt.AnyURI `xml:"http://www.opengis.net/gml about,attr" json:"about,omitempty"`
 
 	//  Reference to an XML Schema fragment that specifies the content model of
 	// the propertys value. This is in conformance with the XML Schema Section
 	// 4.14 Referencing Schemas from Elsewhere.
-	RemoteSchema xsdt.AnyURI `xml:"http://www.opengis.net/gml remoteSchema,attr"`
+	RemoteSchema xsdt.AnyURI `xml:"http://www.opengis.net/gml remoteSchema,attr" json:"remoteSchema,omitempty"`
 }
 
 type Interior struct {
 	// A boundary of a surface consists of a number of rings. The "interior" rings
 	// seperate the surface / surface patch from the area enclosed by the rings.
-	Interiors []*AbstractRingProperty `xml:"http://www.opengis.net/gml interior"`
+	Interiors []*AbstractRingProperty `xml:"http://www.opengis.net/gml interior" json:"interior,omitempty"`
 
 	// Deprecated with GML 3.0, included only for backwards compatibility with
 	// GML 2. Use "interior" instead.
-	InnerBoundaryIses []*AbstractRingProperty `xml:"http://www.opengis.net/gml innerBoundaryIs"`
+	InnerBoundaryIses []*AbstractRingProperty `xml:"http://www.opengis.net/gml innerBoundaryIs" json:"innerBoundaryIs,omitempty"`
 }
 
 type Exterior struct {
 	//  A boundary of a surface consists of a number of rings. In the normal 2D case, one of these rings is distinguished as being the exterior boundary. In a general manifold this is not always possible, in which case all boundaries shall be listed as interior boundaries, and the exterior will be empty.
-	Exterior *AbstractRingProperty `xml:"http://www.opengis.net/gml exterior"`
+	Exterior *AbstractRingProperty `xml:"http://www.opengis.net/gml exterior" json:"exterior,omitempty"`
 
 	//  Deprecated with GML 3.0, included only for backwards compatibility with GML 2. Use "exterior" instead.
-	OuterBoundaryIs *AbstractRingProperty `xml:"http://www.opengis.net/gml outerBoundaryIs"`
+	OuterBoundaryIs *AbstractRingProperty `xml:"http://www.opengis.net/gml outerBoundaryIs" json:"outerBoundaryIs,omitempty"`
 }
 
 type AbstractRingProperty struct {
 	//  The "_Ring" element is the abstract head of the substituition group for all closed boundaries of a surface patch.
 	_Ring *AbstractGeometry `xml:"http://www.opengis.net/gml _Ring"`
 
-	LinearRing *LinearRing `xml:"http://www.opengis.net/gml LinearRing"`
+	LinearRing *LinearRing `xml:"http://www.opengis.net/gml LinearRing" json:"LinearRing,omitempty"`
 
-	Ring *Ring `xml:"http://www.opengis.net/gml Ring"`
+	Ring *Ring `xml:"http://www.opengis.net/gml Ring" json:"Ring,omitempty"`
 }
 
 type LinearRing struct {
@@ -292,27 +292,27 @@ type LinearRing struct {
 	//  1. A sequence of "pos" (DirectPositionType) or "pointProperty" (PointPropertyType) elements. "pos" elements are control points that are only part of this ring, "pointProperty" elements contain a point that may be referenced from other geometry elements or reference another point defined outside of this ring (reuse of existing points).
 	//  2. The "posList" element allows for a compact way to specifiy the coordinates of the control points, if all control points are in the same coordinate reference systems and belong to this ring only. The number of direct positions in the list must be at least four.
 	//  Deprecated with GML version 3.1.0. Use "posList" instead.
-	Coordinates *Coordinates `xml:"http://www.opengis.net/gml coordinates"`
+	Coordinates *Coordinates `xml:"http://www.opengis.net/gml coordinates" json:"coordinates,omitempty"`
 
 	//  GML supports two different ways to specify the control points of a linear ring.
 	//  1. A sequence of "pos" (DirectPositionType) or "pointProperty" (PointPropertyType) elements. "pos" elements are control points that are only part of this ring, "pointProperty" elements contain a point that may be referenced from other geometry elements or reference another point defined outside of this ring (reuse of existing points).
 	//  2. The "posList" element allows for a compact way to specifiy the coordinates of the control points, if all control points are in the same coordinate reference systems and belong to this ring only. The number of direct positions in the list must be at least four.
 	//  Deprecated with GML version 3.0 and included for backwards compatibility with GML 2. Use "pos" elements instead.
-	Coord *Coord `xml:"http://www.opengis.net/gml coord"`
+	Coord *Coord `xml:"http://www.opengis.net/gml coord" json:"coord,omitempty"`
 
-	Poses []*DirectPosition `xml:"http://www.opengis.net/gml pos"`
+	Poses []*DirectPosition `xml:"http://www.opengis.net/gml pos" json:"pos,omitempty"`
 
-	PointProperties []*PointProperty `xml:"http://www.opengis.net/gml pointProperty"`
+	PointProperties []*PointProperty `xml:"http://www.opengis.net/gml pointProperty" json:"pointProperty,omitempty"`
 
 	//  Deprecated with GML version 3.1.0. Use "pointProperty" instead. Included for backwards compatibility with GML 3.0.0.
-	PointReps []*PointProperty `xml:"http://www.opengis.net/gml pointRep"`
+	PointReps []*PointProperty `xml:"http://www.opengis.net/gml pointRep" json:"pointRep,omitempty"`
 
 	AbstractGeometry
 
 	//  GML supports two different ways to specify the control points of a linear ring.
 	//  1. A sequence of "pos" (DirectPositionType) or "pointProperty" (PointPropertyType) elements. "pos" elements are control points that are only part of this ring, "pointProperty" elements contain a point that may be referenced from other geometry elements or reference another point defined outside of this ring (reuse of existing points).
 	//  2. The "posList" element allows for a compact way to specifiy the coordinates of the control points, if all control points are in the same coordinate reference systems and belong to this ring only. The number of direct positions in the list must be at least four.
-	PosList *DirectPosition `xml:"http://www.opengis.net/gml posList"`
+	PosList *DirectPosition `xml:"http://www.opengis.net/gml posList" json:"posList,omitempty"`
 }
 
 type Ring struct {
@@ -320,20 +320,20 @@ type Ring struct {
 
 	//  This element references or contains one curve in the composite curve. The curves are contiguous, the collection of curves is ordered.
 	//  NOTE: This definition allows for a nested structure, i.e. a CompositeCurve may use, for example, another CompositeCurve as a curve member.
-	CurveMembers []*CurveProperty `xml:"http://www.opengis.net/gml curveMember"`
+	CurveMembers []*CurveProperty `xml:"http://www.opengis.net/gml curveMember" json:"curveMember,omitempty"`
 }
 
 type CurveProperty struct {
 	//  The "_Curve" element is the abstract head of the substituition group for all (continuous) curve elements.
 	_Curve *AbstractGeometry `xml:"http://www.opengis.net/gml _Curve"`
 
-	LineString *LineString `xml:"http://www.opengis.net/gml LineString"`
+	LineString *LineString `xml:"http://www.opengis.net/gml LineString" json:"LineString,omitempty"`
 
-	CompositeCurve *CompositeCurve `xml:"http://www.opengis.net/gml CompositeCurve"`
+	CompositeCurve *CompositeCurve `xml:"http://www.opengis.net/gml CompositeCurve" json:"CompositeCurve,omitempty"`
 
-	Curve *Curve `xml:"http://www.opengis.net/gml Curve"`
+	Curve *Curve `xml:"http://www.opengis.net/gml Curve" json:"Curve,omitempty"`
 
-	OrientableCurve *OrientableCurve `xml:"http://www.opengis.net/gml OrientableCurve"`
+	OrientableCurve *OrientableCurve `xml:"http://www.opengis.net/gml OrientableCurve" json:"OrientableCurve,omitempty"`
 
 	AssociationGroup
 }
@@ -350,11 +350,11 @@ type AssociationGroup struct {
 	//  Reference to an XML Schema fragment that specifies the content model of
 	// the propertys value. This is in conformance with the XML Schema Section
 	// 4.14 Referencing Schemas from Elsewhere.
-	RemoteSchema xsdt.AnyURI `xml:"http://www.opengis.net/gml remoteSchema,attr"`
+	RemoteSchema xsdt.AnyURI `xml:"http://www.opengis.net/gml remoteSchema,attr" json:"remoteSchema,omitempty"`
 }
 
 type PointProperty struct {
-	Point *Point `xml:"http://www.opengis.net/gml Point"`
+	Point *Point `xml:"http://www.opengis.net/gml Point" json:"Point,omitempty"`
 
 	AssociationGroup
 }
@@ -365,7 +365,7 @@ type CompositeCurve struct {
 
 	//  This element references or contains one curve in the composite curve. The curves are contiguous, the collection of curves is ordered.
 	//  NOTE: This definition allows for a nested structure, i.e. a CompositeCurve may use, for example, another CompositeCurve as a curve member.
-	CurveMembers []*CurveProperty `xml:"http://www.opengis.net/gml curveMember"`
+	CurveMembers []*CurveProperty `xml:"http://www.opengis.net/gml curveMember" json:"curveMember,omitempty"`
 }
 
 //  Utility type used in various places
@@ -375,27 +375,27 @@ type Sign xsdt.String
 
 type OrientableCurve struct {
 	//  If the orientation is "+", then the OrientableCurve is identical to the baseCurve. If the orientation is "-", then the OrientableCurve is related to another _Curve with a parameterization that reverses the sense of the curve traversal. "+" is the default value.
-	Orientation Sign `xml:"http://www.opengis.net/gml orientation,attr"`
+	Orientation Sign `xml:"http://www.opengis.net/gml orientation,attr" json:"orientation,omitempty"`
 
 	AbstractGeometry
 
 	//  References or contains the base curve (positive orientation).
 	//  NOTE: This definition allows for a nested structure, i.e. an OrientableCurve may use another OrientableCurve as its base curve.
-	BaseCurve *CurveProperty `xml:"http://www.opengis.net/gml baseCurve"`
+	BaseCurve *CurveProperty `xml:"http://www.opengis.net/gml baseCurve" json:"baseCurve,omitempty"`
 }
 
 type Curve struct {
 	AbstractGeometry
 
 	//  This element encapsulates the segments of the curve.
-	Segments *CurveSegments `xml:"http://www.opengis.net/gml segments"`
+	Segments *CurveSegments `xml:"http://www.opengis.net/gml segments" json:"segments,omitempty"`
 }
 
 type CurveSegments struct {
 	//  The "_CurveSegment" element is the abstract head of the substituition group for all curve segment elements, i.e. continuous segments of the same interpolation mechanism.
 	_CurveSegments []*CurveSegment `xml:"http://www.opengis.net/gml _CurveSegment"`
 
-	ArcStrings []*ArcString `xml:"http://www.opengis.net/gml ArcString"`
+	ArcStrings []*ArcString `xml:"http://www.opengis.net/gml ArcString" json:"ArcString,omitempty"`
 
 	// TODO:
 	//XsdGoPkgHasElems_ArcStringByBulge
@@ -423,15 +423,15 @@ type CurveSegments struct {
 }
 
 type ArcStrings struct {
-	ArcStrings []*ArcString `xml:"http://www.opengis.net/gml ArcString"`
+	ArcStrings []*ArcString `xml:"http://www.opengis.net/gml ArcString" json:"ArcString,omitempty"`
 
 	Arc
 }
 
 type Arcs struct {
-	Arcs []*Arc `xml:"http://www.opengis.net/gml Arc"`
+	Arcs []*Arc `xml:"http://www.opengis.net/gml Arc" json:"Arc,omitempty"`
 
-	Circles []*Arc `xml:"http://www.opengis.net/gml Circle"`
+	Circles []*Arc `xml:"http://www.opengis.net/gml Circle" json:"Circle,omitempty"`
 }
 
 type Arc struct {
@@ -440,23 +440,23 @@ type Arc struct {
 	//  GML supports two different ways to specify the control points of a curve segment.
 	//  1. A sequence of "pos" (DirectPositionType) or "pointProperty" (PointPropertyType) elements. "pos" elements are control points that are only part of this curve segment, "pointProperty" elements contain a point that may be referenced from other geometry elements or reference another point defined outside of this curve segment (reuse of existing points).
 	//  2. The "posList" element allows for a compact way to specifiy the coordinates of the control points, if all control points are in the same coordinate reference systems and belong to this curve segment only. The number of direct positions in the list must be three.
-	PosList *DirectPosition `xml:"http://www.opengis.net/gml posList"`
+	PosList *DirectPosition `xml:"http://www.opengis.net/gml posList" json:"posList,omitempty"`
 
 	//  GML supports two different ways to specify the control points of a curve segment.
 	//  1. A sequence of "pos" (DirectPositionType) or "pointProperty" (PointPropertyType) elements. "pos" elements are control points that are only part of this curve segment, "pointProperty" elements contain a point that may be referenced from other geometry elements or reference another point defined outside of this curve segment (reuse of existing points).
 	//  2. The "posList" element allows for a compact way to specifiy the coordinates of the control points, if all control points are in the same coordinate reference systems and belong to this curve segment only. The number of direct positions in the list must be three.
 	//  Deprecated with GML version 3.1.0. Use "posList" instead.
-	Coordinates *Coordinates `xml:"http://www.opengis.net/gml coordinates"`
+	Coordinates *Coordinates `xml:"http://www.opengis.net/gml coordinates" json:"coordinates,omitempty"`
 
-	Poses []*DirectPosition `xml:"http://www.opengis.net/gml pos"`
+	Poses []*DirectPosition `xml:"http://www.opengis.net/gml pos" json:"pos,omitempty"`
 
-	PointProperties []*PointProperty `xml:"http://www.opengis.net/gml pointProperty"`
+	PointProperties []*PointProperty `xml:"http://www.opengis.net/gml pointProperty" json:"pointProperty,omitempty"`
 
 	//  Deprecated with GML version 3.1.0. Use "pointProperty" instead. Included for backwards compatibility with GML 3.0.0.
-	PointReps []*PointProperty `xml:"http://www.opengis.net/gml pointRep"`
+	PointReps []*PointProperty `xml:"http://www.opengis.net/gml pointRep" json:"pointRep,omitempty"`
 
 	//  An arc is an arc string consiting of a single arc, the attribute is fixed to "1".
-	NumArc xsdt.Integer `xml:"http://www.opengis.net/gml numArc,attr"`
+	NumArc *xsdt.Integer `xml:"http://www.opengis.net/gml numArc,attr,omitempty" json:"numArc,omitempty"`
 }
 
 //  A Ring is used to represent a single connected component of a surface boundary. It consists of a sequence of curves connected in a cycle (an object whose boundary is empty).
@@ -484,42 +484,42 @@ type CurveInterpolation xsdt.String
 type ArcString struct {
 	//  The attribute "interpolation" specifies the curve interpolation mechanism used for this segment. This mechanism
 	//  uses the control points and control parameters to determine the position of this curve segment. For an ArcString the interpolation is fixed as "circularArc3Points".
-	Interpolation CurveInterpolation `xml:"http://www.opengis.net/gml interpolation,attr"`
+	Interpolation CurveInterpolation `xml:"http://www.opengis.net/gml interpolation,attr" json:"interpolation,omitempty"`
 
 	//  The number of arcs in the arc string can be explicitly stated in this attribute. The number of control points in the arc string must be 2 * numArc + 1.
-	NumArc xsdt.Integer `xml:"http://www.opengis.net/gml numArc,attr"`
+	NumArc *xsdt.Integer `xml:"http://www.opengis.net/gml numArc,attr,omitempty" json:"numArc,omitempty"`
 
 	CurveSegment
 
 	//  GML supports two different ways to specify the control points of a curve segment.
 	//  1. A sequence of "pos" (DirectPositionType) or "pointProperty" (PointPropertyType) elements. "pos" elements are control points that are only part of this curve segment, "pointProperty" elements contain a point that may be referenced from other geometry elements or reference another point defined outside of this curve segment (reuse of existing points).
 	//  2. The "posList" element allows for a compact way to specifiy the coordinates of the control points, if all control points are in the same coordinate reference systems and belong to this curve segment only. The number of direct positions in the list must be at least three.
-	PosList *DirectPosition `xml:"http://www.opengis.net/gml posList"`
+	PosList *DirectPosition `xml:"http://www.opengis.net/gml posList" json:"posList,omitempty"`
 
 	//  GML supports two different ways to specify the control points of a curve segment.
 	//  1. A sequence of "pos" (DirectPositionType) or "pointProperty" (PointPropertyType) elements. "pos" elements are control points that are only part of this curve segment, "pointProperty" elements contain a point that may be referenced from other geometry elements or reference another point defined outside of this curve segment (reuse of existing points).
 	//  2. The "posList" element allows for a compact way to specifiy the coordinates of the control points, if all control points are in the same coordinate reference systems and belong to this curve segment only. The number of direct positions in the list must be at least three.
 	//  Deprecated with GML version 3.1.0. Use "posList" instead.
-	Coordinates *Coordinates `xml:"http://www.opengis.net/gml coordinates"`
+	Coordinates *Coordinates `xml:"http://www.opengis.net/gml coordinates" json:"coordinates,omitempty"`
 
-	Poses []*DirectPosition `xml:"http://www.opengis.net/gml pos"`
+	Poses []*DirectPosition `xml:"http://www.opengis.net/gml pos" json:"pos,omitempty"`
 
-	PointProperties []*PointProperty `xml:"http://www.opengis.net/gml pointProperty"`
+	PointProperties []*PointProperty `xml:"http://www.opengis.net/gml pointProperty" json:"pointProperty,omitempty"`
 
 	//  Deprecated with GML version 3.1.0. Use "pointProperty" instead. Included for backwards compatibility with GML 3.0.0.
-	PointReps []*PointProperty `xml:"http://www.opengis.net/gml pointRep"`
+	PointReps []*PointProperty `xml:"http://www.opengis.net/gml pointRep" json:"pointRep,omitempty"`
 }
 
 type CurveSegment struct {
 	//  The attribute "numDerivativesAtStart" specifies the type of continuity between this curve segment and its predecessor. If this is the first curve segment in the curve, one of these values, as appropriate, is ignored. The default value of "0" means simple continuity, which is a mandatory minimum level of continuity. This level is referred to as "C 0 " in mathematical texts. A value of 1 means that the function and its first derivative are continuous at the appropriate end point: "C 1 " continuity. A value of "n" for any integer means the function and its first n derivatives are continuous: "C n " continuity.
 	//  NOTE: Use of these values is only appropriate when the basic curve definition is an underdetermined system. For example, line string segments cannot support continuity above C 0 , since there is no spare control parameter to adjust the incoming angle at the end points of the segment. Spline functions on the other hand often have extra degrees of freedom on end segments that allow them to adjust the values of the derivatives to support C 1 or higher continuity.
-	NumDerivativesAtStart xsdt.Integer `xml:"http://www.opengis.net/gml numDerivativesAtStart,attr"`
+	NumDerivativesAtStart *xsdt.Integer `xml:"http://www.opengis.net/gml numDerivativesAtStart,attr,omitempty" json:"numDerivativesAtStart,omitempty"`
 
 	//  The attribute "numDerivativesAtEnd" specifies the type of continuity between this curve segment and its successor. If this is the last curve segment in the curve, one of these values, as appropriate, is ignored. The default value of "0" means simple continuity, which is a mandatory minimum level of continuity. This level is referred to as "C 0 " in mathematical texts. A value of 1 means that the function and its first derivative are continuous at the appropriate end point: "C 1 " continuity. A value of "n" for any integer means the function and its first n derivatives are continuous: "C n " continuity.
 	//  NOTE: Use of these values is only appropriate when the basic curve definition is an underdetermined system. For example, line string segments cannot support continuity above C 0 , since there is no spare control parameter to adjust the incoming angle at the end points of the segment. Spline functions on the other hand often have extra degrees of freedom on end segments that allow them to adjust the values of the derivatives to support C 1 or higher continuity.
-	NumDerivativesAtEnd xsdt.Integer `xml:"http://www.opengis.net/gml numDerivativesAtEnd,attr"`
+	NumDerivativesAtEnd *xsdt.Integer `xml:"http://www.opengis.net/gml numDerivativesAtEnd,attr,omitempty" json:"numDerivativesAtEnd,omitempty"`
 
 	//  The attribute "numDerivativesInterior" specifies the type of continuity that is guaranteed interior to the curve. The default value of "0" means simple continuity, which is a mandatory minimum level of continuity. This level is referred to as "C 0 " in mathematical texts. A value of 1 means that the function and its first derivative are continuous at the appropriate end point: "C 1 " continuity. A value of "n" for any integer means the function and its first n derivatives are continuous: "C n " continuity.
 	//  NOTE: Use of these values is only appropriate when the basic curve definition is an underdetermined system. For example, line string segments cannot support continuity above C 0 , since there is no spare control parameter to adjust the incoming angle at the end points of the segment. Spline functions on the other hand often have extra degrees of freedom on end segments that allow them to adjust the values of the derivatives to support C 1 or higher continuity.
-	NumDerivativeInterior xsdt.Integer `xml:"http://www.opengis.net/gml numDerivativeInterior,attr"`
+	NumDerivativeInterior *xsdt.Integer `xml:"http://www.opengis.net/gml numDerivativeInterior,attr,omitempty" json:"numDerivativeInterior,omitempty"`
 }
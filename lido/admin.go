@@ -0,0 +1,183 @@
+package lido
+
+// Returns a legal body, e.g. an institution, with a preferred name. Add
+// identifiers with AppendID.
+func NewLegalBody(name string, lang string) *LegalBodyRef {
+	appellation := &Appellation{}
+	appellation.Append(name, lang, true)
+	return &LegalBodyRef{LegalBodyNames: []*Appellation{appellation}}
+}
+
+// Appends an identifier, e.g. an ISIL or VIAF ID, to a legal body.
+func (b *LegalBodyRef) AppendID(source string, idType string, id string) {
+	b.LegalBodyIDs = append(b.LegalBodyIDs, &Identifier{
+		Value:  ToXsdt(id),
+		Source: ToXsdt(source),
+		Type:   ToXsdt(idType),
+	})
+}
+
+// Appends the address of the legal body's website.
+func (b *LegalBodyRef) AppendWeblink(url string) {
+	b.LegalBodyWeblinks = append(b.LegalBodyWeblinks, &WebResource{XsdtString: ToXsdt(url)})
+}
+
+func (am *AdministrativeMetadata) recordWrap() *RecordWrap {
+	if am.RecordWrap == nil {
+		am.RecordWrap = &RecordWrap{}
+	}
+	return am.RecordWrap
+}
+
+// Sets the identifier of the metadata record in the contributor's system,
+// replacing any existing ones.
+func (am *AdministrativeMetadata) SetRecordID(recSource string, recType string, recID string) {
+	am.recordWrap().RecordIDs = []*Identifier{{
+		Value:  ToXsdt(recID),
+		Source: ToXsdt(recSource),
+		Type:   ToXsdt(recType),
+	}}
+}
+
+// Returns the first record identifier, or "" if there is none.
+func (am *AdministrativeMetadata) RecordID() string {
+	if am.RecordWrap == nil {
+		return ""
+	}
+	for _, id := range am.RecordWrap.RecordIDs {
+		if id != nil && id.Value != "" {
+			return string(id.Value)
+		}
+	}
+	return ""
+}
+
+// Sets the type of the record, e.g. a single object or a collection.
+func (am *AdministrativeMetadata) SetRecordType(recordType *Concept) {
+	am.recordWrap().RecordType = recordType
+}
+
+// Sets the institution or person that created the record, replacing any
+// existing ones.
+func (am *AdministrativeMetadata) SetRecordSource(source *LegalBodyRef) {
+	am.recordWrap().RecordSources = []*LegalBodyRef{source}
+}
+
+// Adds a link to a web page about the object, e.g. its page in the online
+// collection, with the MIME type of the page if known.
+func (am *AdministrativeMetadata) AddRecordInfoLink(url string, mimeType string) *RecordInfo {
+	rw := am.recordWrap()
+	info := &RecordInfo{RecordInfoLinks: []*WebResource{{
+		XsdtString:     ToXsdt(url),
+		FormatResource: ToXsdt(mimeType),
+	}}}
+	rw.RecordInfoSets = append(rw.RecordInfoSets, info)
+	return info
+}
+
+// Returns a rights statement. The holder may be nil, and the credit line and
+// the dates of the span may be empty. Leave lang empty for a credit line in
+// the language of the administrative metadata.
+func NewRights(holder *LegalBodyRef, creditLine string, lang string, earliest string, latest string) *Rights {
+	r := &Rights{}
+	if holder != nil {
+		r.RightsHolders = append(r.RightsHolders, holder)
+	}
+	if creditLine != "" {
+		r.CreditLines = append(r.CreditLines, NewText(creditLine, lang))
+	}
+	if earliest != "" || latest != "" {
+		r.RightsDate = NewDateSpan(earliest, latest)
+	}
+	return r
+}
+
+// Appends the type of the rights, e.g. copyright or a license such as
+// http://creativecommons.org/publicdomain/zero/1.0/.
+func (r *Rights) AppendType(rightsType *Concept) {
+	r.RightsTypes = append(r.RightsTypes, rightsType)
+}
+
+// Adds rights in the object / work, as made by NewRights.
+func (am *AdministrativeMetadata) AddRights(holder *LegalBodyRef, creditLine string, earliest string, latest string) *Rights {
+	if am.RightsWorkWrap == nil {
+		am.RightsWorkWrap = &RightsWorkWrap{}
+	}
+	r := NewRights(holder, creditLine, "", earliest, latest)
+	am.RightsWorkWrap.RightsWorkSets = append(am.RightsWorkWrap.RightsWorkSets, r)
+	return r
+}
+
+// Adds rights in the record itself, as made by NewRights.
+func (am *AdministrativeMetadata) AddRecordRights(holder *LegalBodyRef, creditLine string, earliest string, latest string) *Rights {
+	rw := am.recordWrap()
+	r := NewRights(holder, creditLine, "", earliest, latest)
+	rw.RecordRights = append(rw.RecordRights, r)
+	return r
+}
+
+// Adds a resource, e.g. an image of the object / work, with a representation
+// linking to it. mimeType is the internet media type of the file, e.g.
+// "image/jpeg".
+func (am *AdministrativeMetadata) AddResource(link string, mimeType string) *ResourceSet {
+	if am.ResourceWrap == nil {
+		am.ResourceWrap = &ResourceWrap{}
+	}
+	set := &ResourceSet{}
+	set.AddRepresentation(link, mimeType, "")
+	am.ResourceWrap.ResourceSets = append(am.ResourceWrap.ResourceSets, set)
+	return set
+}
+
+// Adds a representation of the resource, e.g. a thumbnail, linking to a
+// file. repType qualifies the representation, e.g. "image_thumb".
+func (s *ResourceSet) AddRepresentation(link string, mimeType string, repType string) *ResourceRep {
+	rep := &ResourceRep{
+		LinkResource: &LinkResource{WebResource: WebResource{
+			XsdtString:     ToXsdt(link),
+			FormatResource: ToXsdt(mimeType),
+		}},
+		Type: ToXsdt(repType),
+	}
+	s.ResourceRepresentations = append(s.ResourceRepresentations, rep)
+	return rep
+}
+
+// Adds a measurement, e.g. ("width", "pixel", "3000"), to the last
+// representation of the resource, creating one without a link if there is
+// none.
+func (s *ResourceSet) AddMeasurement(measurementType string, unit string, value string) *AspectMeasurements {
+	if len(s.ResourceRepresentations) == 0 {
+		s.ResourceRepresentations = append(s.ResourceRepresentations, &ResourceRep{})
+	}
+	rep := s.ResourceRepresentations[len(s.ResourceRepresentations)-1]
+	if len(rep.ResourceMeasurementsSets) == 0 {
+		rep.ResourceMeasurementsSets = append(rep.ResourceMeasurementsSets, &MeasurementsSet{})
+	}
+	return rep.ResourceMeasurementsSets[0].AddMeasurement(measurementType, unit, value)
+}
+
+// Sets the type of the resource, e.g. an image or a video.
+func (s *ResourceSet) SetType(resourceType *Concept) {
+	s.ResourceType = resourceType
+}
+
+// Appends the perspective of the resource, e.g. front or detail view.
+func (s *ResourceSet) AppendPerspective(perspective *Concept) {
+	s.ResourcePerspectives = append(s.ResourcePerspectives, perspective)
+}
+
+// Adds a description of the resource.
+func (s *ResourceSet) AddDescription(value string, lang string) *Note {
+	note := &Note{Text: *NewText(value, lang)}
+	s.ResourceDescriptions = append(s.ResourceDescriptions, note)
+	return note
+}
+
+// Adds rights in the resource, e.g. the photographer's copyright, as made by
+// NewRights.
+func (s *ResourceSet) AddRights(holder *LegalBodyRef, creditLine string, earliest string, latest string) *Rights {
+	r := NewRights(holder, creditLine, "", earliest, latest)
+	s.RightsResources = append(s.RightsResources, r)
+	return r
+}
@@ -0,0 +1,352 @@
+package lido
+
+// Builders for the descriptive metadata. Each Add method creates the wraps
+// and sets leading to the element on demand, appends the element, and returns
+// it so that optional attributes can be set on it. Leave lang empty for texts
+// in the language of the descriptive metadata.
+
+// Returns a text element, e.g. for a display element.
+func NewText(value string, lang string) *Text {
+	return &Text{
+		Value: ToXsdt(value),
+		Lang:  ToLang(lang),
+	}
+}
+
+// Returns an actor with a preferred name. Add identifiers with AppendID.
+func NewActor(name string, lang string) *Actor {
+	actor := &Actor{}
+	appellation := &Appellation{}
+	appellation.Append(name, lang, true)
+	actor.NameActorSets = append(actor.NameActorSets, appellation)
+	return actor
+}
+
+// Appends an identifier, e.g. a ULAN ID, to an actor.
+func (a *Actor) AppendID(source string, idType string, id string) {
+	a.ActorIDs = append(a.ActorIDs, &Identifier{
+		Value:  ToXsdt(id),
+		Source: ToXsdt(source),
+		Type:   ToXsdt(idType),
+	})
+}
+
+// Returns a place with a preferred name. Add identifiers with AppendID.
+func NewPlace(name string, lang string) *Place {
+	place := &Place{}
+	appellation := &Appellation{}
+	appellation.Append(name, lang, true)
+	place.NamePlaceSets = append(place.NamePlaceSets, appellation)
+	return place
+}
+
+// Appends an identifier, e.g. a TGN ID, to a place.
+func (p *Place) AppendID(source string, idType string, id string) {
+	p.PlaceIDs = append(p.PlaceIDs, &Identifier{
+		Value:  ToXsdt(id),
+		Source: ToXsdt(source),
+		Type:   ToXsdt(idType),
+	})
+}
+
+// Returns a span from earliest to latest. Either may be empty.
+func NewDateSpan(earliest string, latest string) *DateSpan {
+	span := &DateSpan{}
+	if earliest != "" {
+		span.EarliestDate = &Date{Value: ToXsdt(earliest)}
+	}
+	if latest != "" {
+		span.LatestDate = &Date{Value: ToXsdt(latest)}
+	}
+	return span
+}
+
+// Adds a title with a single appellation value.
+func (dm *DescriptiveMetadata) AddTitle(value string, lang string, pref bool, titleType string) *Title {
+	title := NewTitle(value, lang, pref, titleType)
+	dm.ObjectID.TitleWrap.Append(title)
+	return title
+}
+
+// Adds an object / work type.
+func (dm *DescriptiveMetadata) AddWorkType(concept *Concept) *ClassificationElement {
+	t := NewConceptClassification(concept)
+	dm.ObjectClass.WorkType.Types = append(dm.ObjectClass.WorkType.Types, t)
+	return t
+}
+
+// Adds a classification, e.g. a collection department or a style.
+func (dm *DescriptiveMetadata) AddClassification(concept *Concept, classificationType string) *ClassificationElement {
+	if dm.ObjectClass.ClassificationWrap == nil {
+		dm.ObjectClass.ClassificationWrap = &ClassificationWrap{}
+	}
+	c := NewConceptClassification(concept)
+	c.Type = ToXsdt(classificationType)
+	dm.ObjectClass.ClassificationWrap.Classifications = append(dm.ObjectClass.ClassificationWrap.Classifications, c)
+	return c
+}
+
+// Adds an inscription with its transcription. Add descriptions with
+// AddDescription.
+func (dm *DescriptiveMetadata) AddInscription(transcription string, lang string) *Inscription {
+	if dm.ObjectID.InscriptionsWrap == nil {
+		dm.ObjectID.InscriptionsWrap = &InscriptionsWrap{}
+	}
+	inscription := &Inscription{}
+	if transcription != "" {
+		inscription.InscriptionTranscriptions = append(inscription.InscriptionTranscriptions, NewText(transcription, lang))
+	}
+	dm.ObjectID.InscriptionsWrap.Inscriptions = append(dm.ObjectID.InscriptionsWrap.Inscriptions, inscription)
+	return inscription
+}
+
+// Adds a description of the inscription, e.g. its position or script.
+func (i *Inscription) AddDescription(value string, lang string) *DescriptiveNote {
+	note := &DescriptiveNote{Values: []*Text{NewText(value, lang)}}
+	i.InscriptionDescriptions = append(i.InscriptionDescriptions, note)
+	return note
+}
+
+// Adds a repository holding the object. The workID, e.g. the inventory
+// number, is left out if empty, and so is the location if place is nil.
+func (dm *DescriptiveMetadata) AddRepository(name string, workID string, place *Place) *Repository {
+	if dm.ObjectID.RepositoryWrap == nil {
+		dm.ObjectID.RepositoryWrap = &RepositoryWrap{}
+	}
+	repository := &Repository{RepositoryLocation: place}
+	if name != "" {
+		appellation := &Appellation{}
+		appellation.Append(name, "", true)
+		repository.RepositoryName = &LegalBodyRef{LegalBodyNames: []*Appellation{appellation}}
+	}
+	if workID != "" {
+		repository.WorkIDs = append(repository.WorkIDs, &WorkID{XsdtString: ToXsdt(workID)})
+	}
+	dm.ObjectID.RepositoryWrap.Repositories = append(dm.ObjectID.RepositoryWrap.Repositories, repository)
+	return repository
+}
+
+// Adds a description of the object / work.
+func (dm *DescriptiveMetadata) AddDescription(value string, lang string) *DescriptiveNote {
+	if dm.ObjectID.Description == nil {
+		dm.ObjectID.Description = &ObjectDescription{}
+	}
+	note := &DescriptiveNote{Values: []*Text{NewText(value, lang)}}
+	dm.ObjectID.Description.Notes = append(dm.ObjectID.Description.Notes, note)
+	return note
+}
+
+// Adds a set of measurements with a display text, e.g. "207 x 319 cm". Leave
+// display empty if there is none.
+func (dm *DescriptiveMetadata) AddMeasurementsSet(display string, lang string) *MeasurementsSet {
+	if dm.ObjectID.MeasurementsWrap == nil {
+		dm.ObjectID.MeasurementsWrap = &MeasurementsWrap{}
+	}
+	set := &MeasurementsSet{}
+	if display != "" {
+		set.DisplayMeasurements = append(set.DisplayMeasurements, NewText(display, lang))
+	}
+	dm.ObjectID.MeasurementsWrap.MeasurementsSets = append(dm.ObjectID.MeasurementsWrap.MeasurementsSets, set)
+	return set
+}
+
+// Adds a measurement, e.g. ("height", "cm", "207"), to the last set of
+// measurements, creating one if there is none. As with
+// MeasurementsSet.AddMeasurement, an empty type or unit is left out and the
+// record then fails Validate.
+func (dm *DescriptiveMetadata) AddMeasurement(measurementType string, unit string, value string) *AspectMeasurements {
+	var set *MeasurementsSet
+	if wrap := dm.ObjectID.MeasurementsWrap; wrap != nil && len(wrap.MeasurementsSets) > 0 {
+		set = wrap.MeasurementsSets[len(wrap.MeasurementsSets)-1]
+	} else {
+		set = dm.AddMeasurementsSet("", "")
+	}
+	return set.AddMeasurement(measurementType, unit, value)
+}
+
+// Adds a measurement, e.g. ("height", "cm", "207"). The type and the unit
+// are left out if empty, but LIDO requires both, so Validate then reports
+// the missing measurementType or measurementUnit; fill them in before
+// publishing the record.
+func (s *MeasurementsSet) AddMeasurement(measurementType string, unit string, value string) *AspectMeasurements {
+	if s.Measurements == nil {
+		s.Measurements = &Measurements{}
+	}
+	m := &AspectMeasurements{Value: Text{Value: ToXsdt(value)}}
+	if measurementType != "" {
+		m.Types = append(m.Types, NewText(measurementType, ""))
+	}
+	if unit != "" {
+		m.Units = append(m.Units, NewText(unit, ""))
+	}
+	s.Measurements.MeasurementsSets = append(s.Measurements.MeasurementsSets, m)
+	return m
+}
+
+// Adds an event.
+func (dm *DescriptiveMetadata) AddEvent(eventType *Concept) *Event {
+	if dm.EventWrap == nil {
+		dm.EventWrap = &EventWrap{}
+	}
+	event := &Event{}
+	if eventType != nil {
+		event.EventTypes = append(event.EventTypes, eventType)
+	}
+	dm.EventWrap.AppendEvent(event)
+	return event
+}
+
+// Adds an actor in the role, which may be nil.
+func (e *Event) AddActor(actor *Actor, role *Concept) *EventActor {
+	ea := &EventActor{ActorInRole: ActorInRole{Actor: actor}}
+	if role != nil {
+		ea.RoleActors = append(ea.RoleActors, &ConceptElement{Concept: *role})
+	}
+	e.EventActors = append(e.EventActors, ea)
+	return ea
+}
+
+// Adds the place where the event happened.
+func (e *Event) AddPlace(place *Place) *EventPlace {
+	ep := &EventPlace{PlaceSet: PlaceSet{Place: place}}
+	e.EventPlaces = append(e.EventPlaces, ep)
+	return ep
+}
+
+// Sets the display date of the event, keeping its date span. Adding a
+// display date in another language keeps the existing ones.
+func (e *Event) SetDisplayDate(value string, lang string) {
+	if e.Date == nil {
+		e.Date = &DateSet{}
+	}
+	for _, t := range e.Date.DisplayDates {
+		if t != nil && t.Lang == ToLang(lang) {
+			t.Value = ToXsdt(value)
+			return
+		}
+	}
+	e.Date.DisplayDates = append(e.Date.DisplayDates, NewText(value, lang))
+}
+
+// Adds a material or technique used in the event; materialsTechType is
+// typically "material" or "technique". Terms are added to the last
+// eventMaterialsTech, which is created if there is none.
+func (e *Event) AddMaterialsTech(concept *Concept, materialsTechType string) *ClassificationElement {
+	if len(e.EventMaterialsTechs) == 0 {
+		e.EventMaterialsTechs = append(e.EventMaterialsTechs, &EventMaterialsTech{})
+	}
+	mt := e.EventMaterialsTechs[len(e.EventMaterialsTechs)-1]
+	if mt.MaterialsTech == nil {
+		mt.MaterialsTech = &MaterialsTech{}
+	}
+	term := NewConceptClassification(concept)
+	term.Type = ToXsdt(materialsTechType)
+	mt.MaterialsTech.TermMaterialsTechs = append(mt.MaterialsTech.TermMaterialsTechs, term)
+	return term
+}
+
+// Adds a display text for the materials and techniques of the event, e.g.
+// "tempera on panel".
+func (e *Event) AddDisplayMaterialsTech(value string, lang string) {
+	if len(e.EventMaterialsTechs) == 0 {
+		e.EventMaterialsTechs = append(e.EventMaterialsTechs, &EventMaterialsTech{})
+	}
+	mt := e.EventMaterialsTechs[len(e.EventMaterialsTechs)-1]
+	mt.DisplayMaterialsTechs = append(mt.DisplayMaterialsTechs, NewText(value, lang))
+}
+
+// Adds a set of subject information. The AddSubject methods of the
+// descriptive metadata add to the last set.
+func (dm *DescriptiveMetadata) AddSubjectSet() *SubjectSet {
+	if dm.ObjectRelationWrap == nil {
+		dm.ObjectRelationWrap = &ObjectRelationWrap{}
+	}
+	if dm.ObjectRelationWrap.SubjectWrap == nil {
+		dm.ObjectRelationWrap.SubjectWrap = &SubjectWrap{}
+	}
+	set := &SubjectSet{}
+	dm.ObjectRelationWrap.SubjectWrap.SubjectSets = append(dm.ObjectRelationWrap.SubjectWrap.SubjectSets, set)
+	return set
+}
+
+// Returns the last subject set, creating one if there is none.
+func (dm *DescriptiveMetadata) lastSubjectSet() *SubjectSet {
+	if r := dm.ObjectRelationWrap; r != nil && r.SubjectWrap != nil && len(r.SubjectWrap.SubjectSets) > 0 {
+		return r.SubjectWrap.SubjectSets[len(r.SubjectWrap.SubjectSets)-1]
+	}
+	return dm.AddSubjectSet()
+}
+
+// Returns the subject of the last subject set, creating both if needed.
+func (dm *DescriptiveMetadata) subject() *Subject {
+	set := dm.lastSubjectSet()
+	if set.Subject == nil {
+		set.Subject = &Subject{}
+	}
+	return set.Subject
+}
+
+// Adds a display text for the subject, e.g. "Allegory of spring".
+func (dm *DescriptiveMetadata) AddDisplaySubject(value string, lang string) {
+	set := dm.lastSubjectSet()
+	set.DisplaySubjects = append(set.DisplaySubjects, NewText(value, lang))
+}
+
+// Adds a concept the object / work depicts or is about, e.g. an
+// iconographic theme. The concept may be nil, to be filled in on the
+// returned element.
+func (dm *DescriptiveMetadata) AddSubjectConcept(concept *Concept) *ConceptElement {
+	s := dm.subject()
+	c := &ConceptElement{}
+	if concept != nil {
+		c.Concept = *concept
+	}
+	s.SubjectConcepts = append(s.SubjectConcepts, c)
+	return c
+}
+
+// Adds an actor the object / work depicts or is about.
+func (dm *DescriptiveMetadata) AddSubjectActor(actor *Actor) *SubjectActor {
+	s := dm.subject()
+	a := &SubjectActor{Actor: actor}
+	s.SubjectActors = append(s.SubjectActors, a)
+	return a
+}
+
+// Adds a place the object / work depicts or is about.
+func (dm *DescriptiveMetadata) AddSubjectPlace(place *Place) *PlaceSet {
+	s := dm.subject()
+	p := &PlaceSet{Place: place}
+	s.SubjectPlaces = append(s.SubjectPlaces, p)
+	return p
+}
+
+// Adds a time the object / work depicts or is about.
+func (dm *DescriptiveMetadata) AddSubjectDate(earliest string, latest string) *DateSpan {
+	s := dm.subject()
+	span := NewDateSpan(earliest, latest)
+	s.SubjectDates = append(s.SubjectDates, span)
+	return span
+}
+
+// Adds a related object / work, identified by objectID if it is not empty,
+// with the relationship, which may be nil, and a display text.
+func (dm *DescriptiveMetadata) AddRelatedWork(relType *Concept, display string, lang string, objectID string) *RelatedWorkSet {
+	if dm.ObjectRelationWrap == nil {
+		dm.ObjectRelationWrap = &ObjectRelationWrap{}
+	}
+	if dm.ObjectRelationWrap.RelatedWorksWrap == nil {
+		dm.ObjectRelationWrap.RelatedWorksWrap = &RelatedWorksWrap{}
+	}
+	work := &ObjectSet{}
+	if display != "" {
+		work.DisplayObjects = append(work.DisplayObjects, NewText(display, lang))
+	}
+	if objectID != "" {
+		work.Object = &Object{ObjectIDs: []*Identifier{{Value: ToXsdt(objectID)}}}
+	}
+	set := &RelatedWorkSet{RelatedWorkRelType: relType, RelatedWork: work}
+	wrap := dm.ObjectRelationWrap.RelatedWorksWrap
+	wrap.RelatedWorkSets = append(wrap.RelatedWorkSets, set)
+	return set
+}
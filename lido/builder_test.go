@@ -0,0 +1,141 @@
+package lido
+
+import (
+	"testing"
+)
+
+func TestDescriptiveBuilders(t *testing.T) {
+	l := &Lido{}
+	l.AppendRecID("test", LocalRecordType, "rec-1")
+	dm := l.CreateDesc("en")
+	dm.AddTitle("Primavera", "it", true, RepositoryTitle)
+	dm.AddWorkType(NewURIConcept("http://vocab.getty.edu/aat/300033618", "painting", ""))
+	dm.AddClassification(NewURIConcept("http://vocab.getty.edu/aat/300021140", "Renaissance", ""), "style")
+	dm.AddInscription("SANDRO", "").AddDescription("lower left", "")
+	dm.AddDescription("Allegory of spring.", "")
+	dm.AddMeasurementsSet("207 x 319 cm", "")
+	dm.AddMeasurement("height", "cm", "207")
+	dm.AddMeasurement("width", "cm", "319")
+
+	florence := NewPlace("Florence", "")
+	florence.AppendID("TGN", URIType, "http://vocab.getty.edu/tgn/7000457")
+	dm.AddRepository("Galleria degli Uffizi", "Inv. 8360", florence)
+
+	botticelli := NewActor("Botticelli, Sandro", "")
+	botticelli.AppendID("ULAN", URIType, "http://vocab.getty.edu/ulan/500010368")
+	production := dm.AddEvent(NewURIConcept("http://terminology.lido-schema.org/lido00007", "Production", ""))
+	production.AddActor(botticelli, NewURIConcept("http://vocab.getty.edu/aat/300025136", "painter", ""))
+	production.AddPlace(florence)
+	production.SetDisplayDate("ca. 1480", "")
+	production.SetDisplayDate("c. 1480", "")
+	production.AddMaterialsTech(NewURIConcept("http://vocab.getty.edu/aat/300015062", "tempera", ""), "material")
+	production.AddMaterialsTech(NewURIConcept("http://vocab.getty.edu/aat/300014078", "panel", ""), "material")
+	production.AddDisplayMaterialsTech("tempera on panel", "")
+
+	dm.AddDisplaySubject("Allegory of spring", "")
+	dm.AddSubjectConcept(NewURIConcept("http://iconclass.org/92C4", "Venus", ""))
+	dm.AddSubjectActor(NewActor("Venus", ""))
+	dm.AddSubjectPlace(NewPlace("Arcadia", ""))
+	dm.AddSubjectDate("", "1482")
+	dm.AddSubjectSet()
+	dm.AddSubjectConcept(NewURIConcept("http://iconclass.org/23D11", "spring", ""))
+	dm.AddRelatedWork(NewURIConcept("http://vocab.getty.edu/aat/300444187", "pendant of", ""), "The Birth of Venus", "", "Inv. 878")
+
+	m := dm.ObjectID.MeasurementsWrap.MeasurementsSets
+	if len(m) != 1 || len(m[0].Measurements.MeasurementsSets) != 2 || m[0].Measurements.MeasurementsSets[1].Value.Value != "319" {
+		t.Errorf("measurements not added to one set: %+v", m)
+	}
+	if got := production.Date.DisplayDates; len(got) != 1 || got[0].Value != "c. 1480" {
+		t.Errorf("display date not replaced: %+v", got)
+	}
+	mts := production.EventMaterialsTechs
+	if len(mts) != 1 || len(mts[0].MaterialsTech.TermMaterialsTechs) != 2 || len(mts[0].DisplayMaterialsTechs) != 1 {
+		t.Errorf("materials not added to one eventMaterialsTech: %+v", mts)
+	}
+	sets := dm.ObjectRelationWrap.SubjectWrap.SubjectSets
+	if len(sets) != 2 || len(sets[0].DisplaySubjects) != 1 || len(sets[0].Subject.SubjectConcepts) != 1 ||
+		len(sets[0].Subject.SubjectActors) != 1 || len(sets[0].Subject.SubjectPlaces) != 1 ||
+		sets[0].Subject.SubjectDates[0].EarliestDate != nil || len(sets[1].Subject.SubjectConcepts) != 1 {
+		t.Errorf("subjects not added to the last set: %+v", sets)
+	}
+	if r := dm.ObjectID.RepositoryWrap.Repositories[0]; r.WorkIDs[0].XsdtString != "Inv. 8360" || r.RepositoryLocation != florence {
+		t.Errorf("unexpected repository: %+v", r)
+	}
+
+	// Only the administrative metadata is missing.
+	errs := validationErrors(l)
+	if len(errs) != 1 || errs[0].Path != "" {
+		t.Errorf("unexpected validation errors: %v", errs)
+	}
+}
+
+func TestBuildersEmptyArguments(t *testing.T) {
+	l := validRecord()
+	dm := l.DescriptiveMetadatas[0]
+	if m := dm.AddMeasurement("", "", "3"); len(m.Types) != 0 || len(m.Units) != 0 {
+		t.Errorf("empty type or unit added: %+v", m)
+	}
+	errs := validationErrors(l)
+	if len(errs) != 2 || errs[0].Msg != "missing measurementType (at least 1 required)" ||
+		errs[1].Msg != "missing measurementUnit (at least 1 required)" {
+		t.Errorf("measurement without type and unit: %v", errs)
+	}
+	if c := dm.AddSubjectConcept(nil); len(c.ConceptIDs) != 0 || len(c.Terms) != 0 {
+		t.Errorf("nil subject concept not added empty: %+v", c)
+	}
+}
+
+func TestAdministrativeBuilders(t *testing.T) {
+	l := &Lido{}
+	l.AppendRecID("test", LocalRecordType, "rec-1")
+	l.CreateDesc("en").AddTitle("Primavera", "it", true, RepositoryTitle)
+	l.CreateDesc("en").AddWorkType(NewURIConcept("http://vocab.getty.edu/aat/300033618", "painting", ""))
+
+	am := l.CreateAdmin("en")
+	if l.CreateAdmin("en") != am || l.CreateAdmin("it") == am {
+		t.Errorf("CreateAdmin does not match by language")
+	}
+	uffizi := NewLegalBody("Galleria degli Uffizi", "")
+	uffizi.AppendID("ISIL", LocalRecordType, "IT-FI0100")
+	uffizi.AppendWeblink("https://www.uffizi.it/")
+	am.SetRecordID("Uffizi", LocalRecordType, "old")
+	am.SetRecordID("Uffizi", LocalRecordType, "8360")
+	am.SetRecordType(NewURIConcept("http://terminology.lido-schema.org/lido00141", "item", ""))
+	am.SetRecordSource(uffizi)
+	am.AddRecordInfoLink("https://www.uffizi.it/opere/primavera", "text/html")
+	am.AddRecordRights(nil, "CC0", "", "").AppendType(NewURIConcept("http://creativecommons.org/publicdomain/zero/1.0/", "CC0", ""))
+	am.AddRights(uffizi, "Gallerie degli Uffizi", "1919", "")
+
+	res := am.AddResource("https://example.org/primavera.jpg", "image/jpeg")
+	res.AddMeasurement("width", "pixel", "3000")
+	res.AddMeasurement("height", "pixel", "1950")
+	res.SetType(NewURIConcept("http://terminology.lido-schema.org/lido00451", "image", ""))
+	res.AppendPerspective(NewURIConcept("http://vocab.getty.edu/aat/300190703", "front", ""))
+	res.AddDescription("Overall view.", "")
+	res.AddRights(NewLegalBody("Example Photo", ""), "Photo: Example", "2020", "2020")
+	res.AddRepresentation("https://example.org/primavera_thumb.jpg", "image/jpeg", "image_thumb")
+
+	if got := am.RecordID(); got != "8360" || len(am.RecordWrap.RecordIDs) != 1 {
+		t.Errorf("record ID not replaced: %+v", am.RecordWrap.RecordIDs)
+	}
+	rights := am.RightsWorkWrap.RightsWorkSets
+	if len(rights) != 1 || rights[0].RightsHolders[0] != uffizi || rights[0].CreditLines[0].Value != "Gallerie degli Uffizi" ||
+		rights[0].RightsDate.EarliestDate.Value != "1919" || rights[0].RightsDate.LatestDate != nil {
+		t.Errorf("unexpected rights: %+v", rights)
+	}
+	reps := res.ResourceRepresentations
+	if len(reps) != 2 || reps[0].LinkResource.FormatResource != "image/jpeg" || reps[1].Type != "image_thumb" ||
+		len(reps[0].ResourceMeasurementsSets) != 1 || len(reps[0].ResourceMeasurementsSets[0].Measurements.MeasurementsSets) != 2 {
+		t.Errorf("unexpected representations: %+v", reps)
+	}
+
+	// Only the Italian administrative metadata lacks a record wrap.
+	errs := validationErrors(l)
+	if len(errs) != 1 {
+		t.Errorf("unexpected validation errors: %v", errs)
+	}
+	l.AdministrativeMetadatas = l.AdministrativeMetadatas[:1]
+	if errs := validationErrors(l); len(errs) != 0 {
+		t.Errorf("unexpected validation errors: %v", errs)
+	}
+}
@@ -14,41 +14,41 @@ type Concept struct {
 
 	// A unique identifier for the concept. Preferably taken from and linking to
 	// a published controlled vocabulary.
-	ConceptIDs []*Identifier `xml:"http://www.lido-schema.org conceptID"`
+	ConceptIDs []*Identifier `xml:"http://www.lido-schema.org conceptID" json:"conceptID,omitempty"`
 
 	// A name for the referred concept, used for indexing.
-	Terms []*Term `xml:"http://www.lido-schema.org term"`
+	Terms []*Term `xml:"http://www.lido-schema.org term" json:"term,omitempty"`
 }
 
 // A name for a concept / term, usually from a controlled vocabulary.
 type Term struct {
-	Value xsdt.String `xml:",chardata"`
+	Value xsdt.String `xml:",chardata" json:"value,omitempty"`
 
-	Lang xsdt.Language `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	Lang xsdt.Language `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty" json:"lang,omitempty"`
 
 	// Qualifies the value as a preferred or alternative variant. Data values:
 	// preferred, alternate
-	Pref xsdt.String `xml:"http://www.lido-schema.org pref,attr,omitempty"`
+	Pref xsdt.String `xml:"http://www.lido-schema.org pref,attr,omitempty" json:"pref,omitempty"`
 
 	//  How to record: Has the two values: "yes" or "no". ”yes” indicates, that
 	// the term is an additional term which is derived from an underlying
 	// controlled vocabulary (eg. synonym, generic term, superordinate term) and
 	// should be used only for retrieval."no" is default.
-	AddedSearchTerm AddedSearchTerm `xml:"http://www.lido-schema.org addedSearchTerm,attr,omitempty"`
+	AddedSearchTerm AddedSearchTerm `xml:"http://www.lido-schema.org addedSearchTerm,attr,omitempty" json:"addedSearchTerm,omitempty"`
 
 	// How to record: Elements with data values are accompanied by the attributes
 	// encodinganalog and label to indicate the format of the data source from
 	// which the data were migrated. The attribute encodinganalog refers to the
 	// internal field label of the source database. The source format is indicated
 	// in the attribute relatedencoding of the lidoWrap
-	EncodingAnalog xsdt.String `xml:"http://www.lido-schema.org encodinganalog,attr,omitempty"`
+	EncodingAnalog xsdt.String `xml:"http://www.lido-schema.org encodinganalog,attr,omitempty" json:"encodinganalog,omitempty"`
 
 	// How to record: Elements with data values are accompanied by the attributes
 	// encodinganalog and label, to indicate the format of the data source from
 	// which the data were migrated. The attribute label refers to the external
 	// label of a data field at the visible user interface. The source format is
 	// indicated in the attribute
-	Label xsdt.String `xml:"http://www.lido-schema.org label,attr,omitempty"`
+	Label xsdt.String `xml:"http://www.lido-schema.org label,attr,omitempty" json:"label,omitempty"`
 }
 
 func NewConcept(conceptID *Identifier, term *Term) *Concept {
@@ -0,0 +1,333 @@
+package lido
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/verisart/xsd/xsdt"
+)
+
+// The precision of a PartialDate.
+type DatePrecision int
+
+const (
+	YearPrecision DatePrecision = iota + 1
+	MonthPrecision
+	DayPrecision
+)
+
+// PartialDate is a calendar date known to the year, month or day, as written
+// in earliestDate and latestDate. Years follow XSD 1.0: there is no year 0,
+// and negative years are BC, so -1 is 1 BC.
+type PartialDate struct {
+	Year int
+
+	// 1 to 12, or 0 at year precision.
+	Month int
+
+	// 1 to 31, or 0 at year or month precision.
+	Day int
+}
+
+var partialDatePattern = regexp.MustCompile(`^(-?\d{4,})(?:-(\d{2})(?:-(\d{2})(T.*)?)?)?$`)
+
+// Parses a date such as "1650", "-0500", "1650-03" or "1650-03-12", whose
+// year has at least four digits. A dateTime, as written by Event.SetDate, has
+// to be valid as an xs:dateTime; its time is ignored, leaving a date at day
+// precision.
+func ParsePartialDate(s string) (PartialDate, error) {
+	s = strings.TrimSpace(s)
+	m := partialDatePattern.FindStringSubmatch(s)
+	if m == nil {
+		return PartialDate{}, fmt.Errorf("lido: invalid date %q", s)
+	}
+	if m[4] != "" {
+		if _, err := xsdt.DateTime(s).Value(); err != nil {
+			return PartialDate{}, fmt.Errorf("lido: invalid dateTime %q: %v", s, err)
+		}
+	}
+	var d PartialDate
+	var err error
+	if d.Year, err = strconv.Atoi(m[1]); err != nil {
+		return PartialDate{}, fmt.Errorf("lido: invalid year in date %q", s)
+	}
+	if m[2] != "" {
+		if d.Month, _ = strconv.Atoi(m[2]); d.Month == 0 {
+			return PartialDate{}, fmt.Errorf("lido: invalid month in date %q", s)
+		}
+	}
+	if m[3] != "" {
+		if d.Day, _ = strconv.Atoi(m[3]); d.Day == 0 {
+			return PartialDate{}, fmt.Errorf("lido: invalid day in date %q", s)
+		}
+	}
+	if err := d.Validate(); err != nil {
+		return PartialDate{}, fmt.Errorf("%v in %q", err, s)
+	}
+	return d, nil
+}
+
+// Returns the precision of the date.
+func (d PartialDate) Precision() DatePrecision {
+	switch {
+	case d.Day != 0:
+		return DayPrecision
+	case d.Month != 0:
+		return MonthPrecision
+	}
+	return YearPrecision
+}
+
+// Returns the number of days in the month of the date.
+func (d PartialDate) daysInMonth() int {
+	year := d.Year
+	if year < 0 {
+		// Go years are astronomical, with 1 BC being year 0.
+		year++
+	}
+	return time.Date(year, time.Month(d.Month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// Checks that the date exists and that it has no day without a month.
+func (d PartialDate) Validate() error {
+	switch {
+	case d.Year == 0:
+		return fmt.Errorf("lido: year 0 does not exist")
+	case d.Month < 0 || d.Month > 12:
+		return fmt.Errorf("lido: invalid month %d", d.Month)
+	case d.Day != 0 && d.Month == 0:
+		return fmt.Errorf("lido: day %d without a month", d.Day)
+	case d.Day < 0 || (d.Day != 0 && d.Day > d.daysInMonth()):
+		return fmt.Errorf("lido: invalid day %d of %04d-%02d", d.Day, d.Year, d.Month)
+	}
+	return nil
+}
+
+// Returns the date in the format of earliestDate and latestDate, e.g. "1650",
+// "-0500", "1650-03" or "1650-03-12", keeping its precision.
+func (d PartialDate) String() string {
+	var s string
+	if d.Year < 0 {
+		s = fmt.Sprintf("-%04d", -d.Year)
+	} else {
+		s = fmt.Sprintf("%04d", d.Year)
+	}
+	switch d.Precision() {
+	case MonthPrecision:
+		s += fmt.Sprintf("-%02d", d.Month)
+	case DayPrecision:
+		s += fmt.Sprintf("-%02d-%02d", d.Month, d.Day)
+	}
+	return s
+}
+
+// Returns the date as English text for a displayDate, e.g. "1650", "500 BC",
+// "March 1650" or "12 March 1650".
+func (d PartialDate) Display() string {
+	s := strconv.Itoa(d.Year)
+	if d.Year < 0 {
+		s = strconv.Itoa(-d.Year) + " BC"
+	}
+	switch d.Precision() {
+	case MonthPrecision:
+		s = time.Month(d.Month).String() + " " + s
+	case DayPrecision:
+		s = strconv.Itoa(d.Day) + " " + time.Month(d.Month).String() + " " + s
+	}
+	return s
+}
+
+// Returns the first and the last day of the date as comparable numbers.
+func (d PartialDate) bounds() (first int, last int) {
+	key := func(month, day int) int { return (d.Year*100+month)*100 + day }
+	switch d.Precision() {
+	case YearPrecision:
+		return key(1, 1), key(12, 31)
+	case MonthPrecision:
+		return key(d.Month, 1), key(d.Month, d.daysInMonth())
+	}
+	return key(d.Month, d.Day), key(d.Month, d.Day)
+}
+
+// DateRange is the typed form of a date span. Either end may be nil for an
+// open range. Approximate ranges are shown as "ca." and their middle year in
+// displayDate text.
+type DateRange struct {
+	Earliest *PartialDate
+
+	Latest *PartialDate
+
+	Approximate bool
+}
+
+// Parses the earliest and latest dates of a range, either of which may be
+// empty, and checks them.
+func NewDateRange(earliest string, latest string) (DateRange, error) {
+	var r DateRange
+	for _, end := range []struct {
+		value string
+		date  **PartialDate
+	}{{earliest, &r.Earliest}, {latest, &r.Latest}} {
+		if strings.TrimSpace(end.value) == "" {
+			continue
+		}
+		d, err := ParsePartialDate(end.value)
+		if err != nil {
+			return DateRange{}, err
+		}
+		*end.date = &d
+	}
+	return r, r.Validate()
+}
+
+// Returns the range of an approximate year, e.g. 1650 plus or minus 10
+// years, shown as "ca. 1650". Ends that would fall on year 0 skip it.
+func ApproximateYear(year int, margin int) DateRange {
+	shift := func(delta int) *PartialDate {
+		y := year + delta
+		if (year > 0 && y <= 0) || (year < 0 && y >= 0) {
+			if delta < 0 {
+				y--
+			} else {
+				y++
+			}
+		}
+		return &PartialDate{Year: y}
+	}
+	return DateRange{Earliest: shift(-margin), Latest: shift(margin), Approximate: true}
+}
+
+// Checks both dates of the range and that the earliest does not start after
+// the latest ends.
+func (r DateRange) Validate() error {
+	for _, d := range []*PartialDate{r.Earliest, r.Latest} {
+		if d == nil {
+			continue
+		}
+		if err := d.Validate(); err != nil {
+			return err
+		}
+	}
+	if r.Earliest != nil && r.Latest != nil {
+		first, _ := r.Earliest.bounds()
+		if _, last := r.Latest.bounds(); first > last {
+			return fmt.Errorf("lido: earliest date %s is after latest date %s", r.Earliest, r.Latest)
+		}
+	}
+	return nil
+}
+
+// Returns the range as English text for a displayDate, e.g. "1650",
+// "1640–1660", "ca. 1650", "March 1650 – May 1651", "500–400 BC", "not
+// before 1650" or "not after 1700", or "" if both ends are open. An
+// approximate range of years is shown by its middle year where it has one.
+func (r DateRange) Display() string {
+	earliest, latest := r.Earliest, r.Latest
+	years := earliest != nil && latest != nil && earliest.Precision() == YearPrecision && latest.Precision() == YearPrecision
+	var display string
+	switch {
+	case earliest == nil && latest == nil:
+		return ""
+	case latest == nil:
+		return "not before " + earliest.Display()
+	case earliest == nil:
+		return "not after " + latest.Display()
+	case *earliest == *latest:
+		display = earliest.Display()
+	case years && r.Approximate && earliest.Year*latest.Year > 0 && (earliest.Year+latest.Year)%2 == 0:
+		display = PartialDate{Year: (earliest.Year + latest.Year) / 2}.Display()
+	case years && latest.Year < 0:
+		display = strconv.Itoa(-earliest.Year) + "–" + strconv.Itoa(-latest.Year) + " BC"
+	case years && earliest.Year > 0:
+		display = earliest.Display() + "–" + latest.Display()
+	default:
+		display = earliest.Display() + " – " + latest.Display()
+	}
+	if r.Approximate {
+		display = "ca. " + display
+	}
+	return display
+}
+
+// Returns the range as a date span, keeping the precision of both dates.
+func (r DateRange) Span() *DateSpan {
+	span := &DateSpan{}
+	if r.Earliest != nil {
+		span.EarliestDate = &Date{Value: ToXsdt(r.Earliest.String())}
+	}
+	if r.Latest != nil {
+		span.LatestDate = &Date{Value: ToXsdt(r.Latest.String())}
+	}
+	return span
+}
+
+// Returns the dates of the span as they are written, e.g. "1640/1660", or
+// one date if both are the same or only one is given, or "" if there is none.
+func (s *DateSpan) String() string {
+	var earliest, latest string
+	if s.EarliestDate != nil {
+		earliest = strings.TrimSpace(string(s.EarliestDate.Value))
+	}
+	if s.LatestDate != nil {
+		latest = strings.TrimSpace(string(s.LatestDate.Value))
+	}
+	switch {
+	case earliest == latest || latest == "":
+		return earliest
+	case earliest == "":
+		return latest
+	}
+	return earliest + "/" + latest
+}
+
+// Parses the earliest and latest dates of the span.
+func (s *DateSpan) Range() (DateRange, error) {
+	var earliest, latest string
+	if s.EarliestDate != nil {
+		earliest = string(s.EarliestDate.Value)
+	}
+	if s.LatestDate != nil {
+		latest = string(s.LatestDate.Value)
+	}
+	return NewDateRange(earliest, latest)
+}
+
+var approximatePattern = regexp.MustCompile(`(?i)^\s*(ca\.|c\.|circa\b|approx\.|um\b)`)
+
+// Parses the date span of the set. The range is approximate if a displayDate
+// starts with "ca.", "c.", "circa", "approx." or "um".
+func (s *DateSet) Range() (DateRange, error) {
+	if s.Date == nil {
+		return DateRange{}, nil
+	}
+	r, err := s.Date.Range()
+	if err != nil {
+		return DateRange{}, err
+	}
+	for _, t := range s.DisplayDates {
+		if t != nil && approximatePattern.MatchString(string(t.Value)) {
+			r.Approximate = true
+		}
+	}
+	return r, nil
+}
+
+// Sets the date of the event to the range, keeping the precision of its
+// dates, and sets the English displayDate to its Display text. Display dates
+// in other languages are kept, so update them too if the range changed.
+func (e *Event) SetDateRange(r DateRange) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	if e.Date == nil {
+		e.Date = &DateSet{}
+	}
+	e.Date.Date = r.Span()
+	if display := r.Display(); display != "" {
+		e.SetDisplayDate(display, "en")
+	}
+	return nil
+}
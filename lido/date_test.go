@@ -0,0 +1,140 @@
+package lido
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsePartialDate(t *testing.T) {
+	tests := []struct {
+		in        string
+		want      PartialDate
+		precision DatePrecision
+		out       string
+		display   string
+	}{
+		{"1650", PartialDate{Year: 1650}, YearPrecision, "1650", "1650"},
+		{" 0650 ", PartialDate{Year: 650}, YearPrecision, "0650", "650"},
+		{"-0500", PartialDate{Year: -500}, YearPrecision, "-0500", "500 BC"},
+		{"1650-03", PartialDate{Year: 1650, Month: 3}, MonthPrecision, "1650-03", "March 1650"},
+		{"1650-03-12", PartialDate{Year: 1650, Month: 3, Day: 12}, DayPrecision, "1650-03-12", "12 March 1650"},
+		{"1650-03-12T00:00:00Z", PartialDate{Year: 1650, Month: 3, Day: 12}, DayPrecision, "1650-03-12", "12 March 1650"},
+		{"2000-02-29", PartialDate{Year: 2000, Month: 2, Day: 29}, DayPrecision, "2000-02-29", "29 February 2000"},
+		{"-0001-02-29", PartialDate{Year: -1, Month: 2, Day: 29}, DayPrecision, "-0001-02-29", "29 February 1 BC"},
+	}
+	for _, test := range tests {
+		got, err := ParsePartialDate(test.in)
+		if err != nil {
+			t.Errorf("ParsePartialDate(%q): %v", test.in, err)
+			continue
+		}
+		if got != test.want || got.Precision() != test.precision || got.String() != test.out || got.Display() != test.display {
+			t.Errorf("ParsePartialDate(%q) = %+v (%v, %q, %q)", test.in, got, got.Precision(), got, got.Display())
+		}
+	}
+
+	for _, in := range []string{"", "ca. 1650", "0", "0000", "1650-13", "1650-00", "1650-01-00", "1900-02-29", "1650-3", "1650/1660", "12", "650", "1650-03-12Tgarbage", "1650-03-12T25:00:00"} {
+		if d, err := ParsePartialDate(in); err == nil {
+			t.Errorf("ParsePartialDate(%q) = %+v, want error", in, d)
+		}
+	}
+}
+
+func TestDateRange(t *testing.T) {
+	tests := []struct {
+		earliest, latest string
+		approximate      bool
+		display          string
+	}{
+		{"1650", "1650", false, "1650"},
+		{"1640", "1660", false, "1640–1660"},
+		{"1640", "1660", true, "ca. 1650"},
+		{"1640", "1661", true, "ca. 1640–1661"},
+		{"1650-03", "1651-05", false, "March 1650 – May 1651"},
+		{"-0500", "-0400", false, "500–400 BC"},
+		{"-0510", "-0490", true, "ca. 500 BC"},
+		{"-0050", "0050", false, "50 BC – 50"},
+		{"1650", "", false, "not before 1650"},
+		{"", "1700", false, "not after 1700"},
+		{"", "", false, ""},
+		{"1650-06", "1650", false, "June 1650 – 1650"},
+	}
+	for _, test := range tests {
+		r, err := NewDateRange(test.earliest, test.latest)
+		if err != nil {
+			t.Errorf("NewDateRange(%q, %q): %v", test.earliest, test.latest, err)
+			continue
+		}
+		r.Approximate = test.approximate
+		if got := r.Display(); got != test.display {
+			t.Errorf("Display of %q–%q = %q, want %q", test.earliest, test.latest, got, test.display)
+		}
+		back, err := r.Span().Range()
+		if err != nil || !rangeEqual(back, r) {
+			t.Errorf("span of %q–%q does not read back: %+v, %v", test.earliest, test.latest, back, err)
+		}
+	}
+
+	for _, bad := range [][2]string{{"1651", "1650-12"}, {"1650-12", "1650-06"}, {"-0400", "-0500"}, {"1650", "16500-01-32"}} {
+		if _, err := NewDateRange(bad[0], bad[1]); err == nil {
+			t.Errorf("NewDateRange(%q, %q) succeeded", bad[0], bad[1])
+		}
+	}
+	if err := (DateRange{Earliest: &PartialDate{Year: 1650}, Latest: &PartialDate{Year: 0}}).Validate(); err == nil {
+		t.Errorf("year 0 validated")
+	}
+
+	for span, want := range map[*DateSpan]string{
+		NewDateSpan("1640", "1660"): "1640/1660",
+		NewDateSpan("1650", "1650"): "1650",
+		NewDateSpan("", "1700"):     "1700",
+		{}:                          "",
+	} {
+		if got := span.String(); got != want {
+			t.Errorf("DateSpan.String() = %q, want %q", got, want)
+		}
+	}
+
+	r := ApproximateYear(3, 5)
+	if *r.Earliest != (PartialDate{Year: -3}) || *r.Latest != (PartialDate{Year: 8}) || r.Display() != "ca. 3 BC – 8" {
+		t.Errorf("unexpected range across year 0: %+v %+v %q", r.Earliest, r.Latest, r.Display())
+	}
+}
+
+func rangeEqual(a, b DateRange) bool {
+	same := func(x, y *PartialDate) bool { return (x == nil && y == nil) || (x != nil && y != nil && *x == *y) }
+	return same(a.Earliest, b.Earliest) && same(a.Latest, b.Latest)
+}
+
+func TestSetDateRange(t *testing.T) {
+	e := &Event{}
+	e.SetDisplayDate("um 1650", "de")
+	if err := e.SetDateRange(ApproximateYear(1650, 10)); err != nil {
+		t.Fatal(err)
+	}
+	if e.Date.Date.EarliestDate.Value != "1640" || e.Date.Date.LatestDate.Value != "1660" {
+		t.Errorf("precision not kept: %+v", e.Date.Date)
+	}
+	if d := e.Date.DisplayDates; len(d) != 2 || d[0].Value != "um 1650" || d[1].Value != "ca. 1650" || d[1].Lang != "en" {
+		t.Errorf("unexpected display dates: %+v %+v", d[0], d[1])
+	}
+	r, err := e.Date.Range()
+	if err != nil || !r.Approximate || r.Display() != "ca. 1650" {
+		t.Errorf("approximate range not read back: %+v, %v", r, err)
+	}
+
+	if err := e.SetDateRange(DateRange{Earliest: &PartialDate{Year: 1660}, Latest: &PartialDate{Year: 1650}}); err == nil {
+		t.Errorf("reversed range set")
+	}
+	if e.Date.Date.EarliestDate.Value != "1640" {
+		t.Errorf("invalid range changed the date")
+	}
+
+	e.SetDate(time.Date(1650, 3, 12, 0, 0, 0, 0, time.UTC), time.Date(1650, 3, 12, 0, 0, 0, 0, time.UTC))
+	if r, err := e.Date.Date.Range(); err != nil || r.Earliest.Precision() != DayPrecision || r.Display() != "12 March 1650" {
+		t.Errorf("timestamps not read as days: %+v, %v", r, err)
+	}
+	if r, err := (&DateSet{}).Range(); err != nil || r.Earliest != nil || r.Latest != nil {
+		t.Errorf("empty set: %+v, %v", r, err)
+	}
+}
@@ -0,0 +1,263 @@
+package lido
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/verisart/xsd/xsdt"
+)
+
+// Sets every field reachable from v to a distinct non-zero value, with one
+// element in every slice. Types already being filled further up are left
+// empty so that recursive types such as Place terminate.
+func fill(v reflect.Value, n *int, filling map[reflect.Type]bool) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if filling[v.Type().Elem()] {
+			return
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(v.Elem(), n, filling)
+	case reflect.Slice:
+		if elem := v.Type().Elem(); elem.Kind() == reflect.Ptr && filling[elem.Elem()] {
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fill(v.Index(0), n, filling)
+	case reflect.Struct:
+		switch v.Type() {
+		case reflect.TypeOf(xsdt.Integer{}):
+			*n++
+			v.Set(reflect.ValueOf(xsdt.NewInteger(int64(*n))))
+			return
+		case reflect.TypeOf(xsdt.Decimal{}):
+			*n++
+			v.Set(reflect.ValueOf(xsdt.NewInteger(int64(*n)).Decimal()))
+			return
+		}
+		filling[v.Type()] = true
+		defer delete(filling, v.Type())
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.PkgPath != "" || f.Tag.Get("json") == "-" {
+				continue
+			}
+			fill(v.Field(i), n, filling)
+		}
+	case reflect.Uint64:
+		*n++
+		v.SetUint(uint64(*n))
+	case reflect.String:
+		*n++
+		switch v.Type() {
+		case reflect.TypeOf(xsdt.Language("")):
+			v.SetString("x-" + strconv.Itoa(*n))
+		default:
+			v.SetString("v" + strconv.Itoa(*n))
+		}
+	}
+}
+
+// Collects the XML element and attribute names of the fields reachable from
+// t.
+func xmlNames(t reflect.Type, names map[string]bool) {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice:
+		xmlNames(t.Elem(), names)
+	case reflect.Struct:
+		if names[t.String()] {
+			return
+		}
+		names[t.String()] = true
+		for i := 0; i < t.NumField(); i++ {
+			tag := strings.Split(t.Field(i).Tag.Get("xml"), ",")[0]
+			if tag != "" {
+				names[tag[strings.LastIndex(tag, " ")+1:]] = true
+			}
+			xmlNames(t.Field(i).Type, names)
+		}
+	}
+}
+
+// Reports the keys of the JSON document that are not XML names.
+func badKeys(v interface{}, names map[string]bool) (bad []string) {
+	switch v := v.(type) {
+	case []interface{}:
+		for _, child := range v {
+			bad = append(bad, badKeys(child, names)...)
+		}
+	case map[string]interface{}:
+		for key, child := range v {
+			if key != "value" && !names[key] {
+				bad = append(bad, key)
+			}
+			bad = append(bad, badKeys(child, names)...)
+		}
+	}
+	return
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	l := &Lido{}
+	n := 0
+	fill(reflect.ValueOf(l).Elem(), &n, map[reflect.Type]bool{})
+
+	data, err := json.Marshal(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	back := &Lido{}
+	if err := json.Unmarshal(data, back); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(l, back) {
+		again, _ := json.Marshal(back)
+		t.Errorf("round trip lost data:\n%s\n%s", data, again)
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	names := map[string]bool{}
+	xmlNames(reflect.TypeOf(l), names)
+	names["srsNameElement"] = true
+	if bad := badKeys(doc, names); len(bad) > 0 {
+		t.Errorf("keys not named after XML names: %v", bad)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	l := &Lido{}
+	l.AppendRecID("test", LocalRecordType, "rec-1")
+	desc := l.CreateDesc("de")
+	desc.AppendAATWorkType(URIType, "http://vocab.getty.edu/aat/300033618", "Gemälde")
+	sortOrder := xsdt.NewInteger(1)
+	desc.ObjectClass.WorkType.Types[0].SortOrder = &sortOrder
+	desc.ObjectClass.WorkType.Types[0].Terms[0].EncodingAnalog = "Objektart"
+
+	data, err := json.Marshal(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"lidoRecID":[{"value":"rec-1","source":"test","type":"local"}],` +
+		`"descriptiveMetadata":[{"objectClassificationWrap":{"objectWorkTypeWrap":{"objectWorkType":[{` +
+		`"conceptID":[{"value":"http://vocab.getty.edu/aat/300033618","source":"AAT","type":"URI"}],` +
+		`"term":[{"value":"Gemälde","encodinganalog":"Objektart"}],"sortorder":"1"}]}},` +
+		`"objectIdentificationWrap":{"titleWrap":{}},"lang":"de"}]}`
+	if string(data) != want {
+		t.Errorf("got\n%s\nwant\n%s", data, want)
+	}
+}
+
+func TestMarshalJSONLD(t *testing.T) {
+	l := &Lido{ObjectPublishedIDs: []*Identifier{{Value: "http://example.org/obj/1", Type: URIType}}}
+	l.AppendRecID("test", LocalRecordType, "rec-1")
+	desc := l.CreateDesc("de")
+	desc.AppendAATWorkType(URIType, "http://vocab.getty.edu/aat/300033618", "Gemälde")
+
+	data, err := l.MarshalJSONLD("https://example.org/lido.jsonld")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc["@context"] != "https://example.org/lido.jsonld" || doc["@type"] != "lido" || doc["@id"] != "http://example.org/obj/1" {
+		t.Errorf("root: %s", data)
+	}
+	if strings.Contains(string(data), `"@id":"rec-1"`) {
+		t.Errorf("local identifier became an IRI: %s", data)
+	}
+	if !strings.Contains(string(data), `"objectWorkType":[{"@id":"http://vocab.getty.edu/aat/300033618","conceptID":[{"@id":"http://vocab.getty.edu/aat/300033618",`) {
+		t.Errorf("concept has no @id: %s", data)
+	}
+
+	back := &Lido{}
+	if err := back.UnmarshalJSONLD(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(l, back) {
+		t.Errorf("JSON-LD does not read back")
+	}
+
+	data, err = l.MarshalJSONLD("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc = nil
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	expanded := expandJSONLD(doc, doc["@context"].(map[string]interface{}))
+	term := first(expanded,
+		"http://www.lido-schema.org/descriptiveMetadata",
+		"http://www.lido-schema.org/objectClassificationWrap",
+		"http://www.lido-schema.org/objectWorkTypeWrap",
+		"http://www.lido-schema.org/objectWorkType",
+		"http://www.lido-schema.org/term",
+		"http://www.w3.org/1999/02/22-rdf-syntax-ns#value")
+	if want := map[string]interface{}{"@value": "Gemälde", "@language": "de"}; !reflect.DeepEqual(term, want) {
+		t.Errorf("got term %v, want %v in %s", term, want, data)
+	}
+}
+
+// Expands the keys of a JSON-LD document with an embedded context, enough to
+// check the IRIs and literals it yields: keys are looked up as terms and
+// compact IRIs, or else appended to @vocab, and every value becomes an array.
+func expandJSONLD(doc interface{}, context map[string]interface{}) []interface{} {
+	switch doc := doc.(type) {
+	case []interface{}:
+		var expanded []interface{}
+		for _, v := range doc {
+			expanded = append(expanded, expandJSONLD(v, context)...)
+		}
+		return expanded
+	case map[string]interface{}:
+		if _, ok := doc["@value"]; ok {
+			return []interface{}{doc}
+		}
+		node := map[string]interface{}{}
+		for key, v := range doc {
+			switch {
+			case key == "@context":
+			case strings.HasPrefix(key, "@"):
+				node[key] = v
+			default:
+				node[expandIRI(key, context)] = expandJSONLD(v, context)
+			}
+		}
+		return []interface{}{node}
+	}
+	return []interface{}{map[string]interface{}{"@value": doc}}
+}
+
+func expandIRI(key string, context map[string]interface{}) string {
+	if term, ok := context[key].(string); ok {
+		key = term
+	}
+	if i := strings.Index(key, ":"); i > 0 {
+		if prefix, ok := context[key[:i]].(string); ok {
+			return prefix + key[i+1:]
+		}
+		return key
+	}
+	return context["@vocab"].(string) + key
+}
+
+// Follows the first value of each property of an expanded document.
+func first(expanded []interface{}, properties ...string) interface{} {
+	v := expanded[0]
+	for _, p := range properties {
+		values, _ := v.(map[string]interface{})[p].([]interface{})
+		if len(values) == 0 {
+			return nil
+		}
+		v = values[0]
+	}
+	return v
+}
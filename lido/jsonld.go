@@ -0,0 +1,222 @@
+package lido
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// The LIDO types also marshal with encoding/json. Every element and attribute
+// becomes a key with its LIDO name, repeatable elements become arrays, the
+// character data of an element becomes "value", and empty values are left
+// out, so that a record round-trips through JSON without loss.
+//
+// JSONLDContext is the JSON-LD context of the documents written by
+// MarshalJSONLD. It maps those keys onto the LIDO namespace and "value" onto
+// rdf:value. Publish it at a stable URL and pass that URL to MarshalJSONLD.
+const JSONLDContext = `{
+  "@context": {
+    "@vocab": "http://www.lido-schema.org/",
+    "lido": "http://www.lido-schema.org/",
+    "gml": "http://www.opengis.net/gml/",
+    "rdf": "http://www.w3.org/1999/02/22-rdf-syntax-ns#",
+    "value": "rdf:value"
+  }
+}`
+
+// Keys whose values are identifiers or web resources. Those with a URI value
+// are given it as their @id.
+var jsonLDIRIKeys = map[string]bool{
+	"actorID":           true,
+	"conceptID":         true,
+	"descriptiveNoteID": true,
+	"eventID":           true,
+	"legalBodyID":       true,
+	"legalBodyWeblink":  true,
+	"lidoRecID":         true,
+	"linkResource":      true,
+	"objectID":          true,
+	"objectPublishedID": true,
+	"objectWebResource": true,
+	"placeID":           true,
+	"recordID":          true,
+	"recordInfoID":      true,
+	"recordInfoLink":    true,
+	"resourceID":        true,
+	"workID":            true,
+}
+
+// Keys of identifiers that name the entity holding them, which takes the
+// first of them with a URI value as its @id: the concept of a conceptID, the
+// actor of an actorID, and so on, and the object of an objectPublishedID.
+var jsonLDEntityKeys = map[string]bool{
+	"actorID":           true,
+	"conceptID":         true,
+	"eventID":           true,
+	"legalBodyID":       true,
+	"objectID":          true,
+	"objectPublishedID": true,
+	"placeID":           true,
+}
+
+// Returns the record as JSON-LD: its JSON view, with "@context" set to
+// contextURL, or to the JSONLDContext itself if contextURL is empty, and
+// "@id" set on every identifier, web resource and identified entity whose
+// identifier is a URI. The value of every text, term and appellation value
+// becomes a value object with its language as resolved by LangResolver, e.g.
+// {"@value": "Gemälde", "@language": "de"}. UnmarshalJSONLD reads the result
+// back.
+func (l *Lido) MarshalJSONLD(contextURL string) ([]byte, error) {
+	data, err := json.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	linkIRIs(doc)
+	tagValues(reflect.ValueOf(l), doc, NewLangResolver(l))
+	if contextURL != "" {
+		doc["@context"] = contextURL
+	} else {
+		var context map[string]interface{}
+		if err := json.Unmarshal([]byte(JSONLDContext), &context); err != nil {
+			return nil, err
+		}
+		doc["@context"] = context["@context"]
+	}
+	doc["@type"] = "lido"
+	return json.Marshal(doc)
+}
+
+// Returns the value of an identifier or web resource if it is an absolute
+// URI.
+func jsonLDIRI(v interface{}) string {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	s, _ := obj["value"].(string)
+	s = strings.TrimSpace(s)
+	if strings.ContainsAny(s, " \t\n") {
+		return ""
+	}
+	if u, err := url.Parse(s); err != nil || !u.IsAbs() {
+		return ""
+	}
+	return s
+}
+
+func linkIRIs(v interface{}) {
+	switch v := v.(type) {
+	case []interface{}:
+		for _, child := range v {
+			linkIRIs(child)
+		}
+	case map[string]interface{}:
+		for key, child := range v {
+			linkIRIs(child)
+			if !jsonLDIRIKeys[key] {
+				continue
+			}
+			ids, ok := child.([]interface{})
+			if !ok {
+				ids = []interface{}{child}
+			}
+			for _, id := range ids {
+				iri := jsonLDIRI(id)
+				if iri == "" {
+					continue
+				}
+				id.(map[string]interface{})["@id"] = iri
+				if _, ok := v["@id"]; !ok && jsonLDEntityKeys[key] {
+					v["@id"] = iri
+				}
+			}
+		}
+	}
+}
+
+// Reads a record written by MarshalJSONLD, ignoring the JSON-LD keywords.
+func (l *Lido) UnmarshalJSONLD(data []byte) error {
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	data, err := json.Marshal(untagValues(doc))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, l)
+}
+
+var taggedTypes = map[reflect.Type]bool{
+	reflect.TypeOf(Text{}):             true,
+	reflect.TypeOf(Term{}):             true,
+	reflect.TypeOf(AppellationValue{}): true,
+}
+
+// Walks v along with its JSON view doc and turns the "value" of every text,
+// term and appellation value into a value object.
+func tagValues(v reflect.Value, doc interface{}, langs *LangResolver) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			tagValues(v.Elem(), doc, langs)
+		}
+	case reflect.Slice:
+		docs, ok := doc.([]interface{})
+		for i := 0; ok && i < v.Len() && i < len(docs); i++ {
+			tagValues(v.Index(i), docs[i], langs)
+		}
+	case reflect.Struct:
+		obj, ok := doc.(map[string]interface{})
+		if !ok {
+			return
+		}
+		if taggedTypes[v.Type()] && v.CanAddr() {
+			if value, ok := obj["value"].(string); ok {
+				tagged := map[string]interface{}{"@value": value}
+				if lang := langs.Tag(v.Addr().Interface()); lang != "" {
+					tagged["@language"] = lang
+				}
+				obj["value"] = tagged
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			name := strings.Split(f.Tag.Get("json"), ",")[0]
+			switch {
+			case name == "-":
+			case name == "" && f.Anonymous:
+				tagValues(v.Field(i), obj, langs)
+			case name != "":
+				tagValues(v.Field(i), obj[name], langs)
+			}
+		}
+	}
+}
+
+// Replaces the value objects in doc by their values.
+func untagValues(doc interface{}) interface{} {
+	switch doc := doc.(type) {
+	case []interface{}:
+		for i, child := range doc {
+			doc[i] = untagValues(child)
+		}
+	case map[string]interface{}:
+		if value, ok := doc["@value"]; ok {
+			return value
+		}
+		for key, child := range doc {
+			doc[key] = untagValues(child)
+		}
+	}
+	return doc
+}
@@ -0,0 +1,211 @@
+package lido
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/verisart/xsd/xsdt"
+)
+
+// The language of values that neither have an xml:lang of their own nor
+// inherit one.
+const Undetermined = "und"
+
+// LangPriority is a list of BCP 47 language ranges, most wanted first, such
+// as ["de-CH", "de", "en"]. The range "*" matches any language.
+//
+// A language matches a range if it is equal to it, if it is the range with
+// subtags removed from the end ("de" for "de-CH"), or if it is the range with
+// subtags added ("de-AT" for "de"), in that order of preference. Ranges are
+// compared case-insensitively. Undetermined values only match "und" or "*",
+// but are preferred to values in languages that match no range at all.
+type LangPriority []string
+
+// Parses a priority list such as "de-CH, de, en", or an HTTP
+// Accept-Language header such as "de-CH, de;q=0.9, en;q=0.5". Ranges are
+// ordered by descending quality, and those with a quality of 0 are left out.
+func ParseLangPriority(s string) LangPriority {
+	type weighted struct {
+		lang string
+		q    float64
+	}
+	var ranges []weighted
+	for _, part := range strings.Split(s, ",") {
+		fields := strings.Split(part, ";")
+		lang := strings.TrimSpace(fields[0])
+		if lang == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		if q > 0 {
+			ranges = append(ranges, weighted{lang, q})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].q > ranges[j].q })
+
+	var p LangPriority
+	for _, r := range ranges {
+		p = append(p, r.lang)
+	}
+	return p
+}
+
+// Returns the rank of a language in the list, lower being better. Languages
+// matching no range rank after all that do, undetermined ones first.
+func (p LangPriority) rank(lang string) int {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if lang == "" {
+		lang = Undetermined
+	}
+	for i, r := range p {
+		r = strings.ToLower(strings.TrimSpace(r))
+		switch {
+		case lang == r:
+			return i * 4
+		case strings.HasPrefix(r, lang+"-"):
+			return i*4 + 1
+		case strings.HasPrefix(lang, r+"-"):
+			return i*4 + 2
+		case r == "*":
+			return i*4 + 3
+		}
+	}
+	if lang == Undetermined {
+		return len(p) * 4
+	}
+	return len(p)*4 + 1
+}
+
+// Reports whether a language matches any range of the list.
+func (p LangPriority) Matches(lang string) bool {
+	return p.rank(lang) < len(p)*4
+}
+
+// LangResolver knows the effective language of the nodes of a record: their
+// own xml:lang, or else that of the nearest element around them with one,
+// such as their descriptiveMetadata or administrativeMetadata element.
+type LangResolver struct {
+	langs map[interface{}]string
+}
+
+var languageType = reflect.TypeOf(xsdt.Language(""))
+
+// Returns a resolver for the nodes of the record. The resolver does not see
+// nodes added to the record later.
+func NewLangResolver(l *Lido) *LangResolver {
+	r := &LangResolver{langs: map[interface{}]string{}}
+	if l != nil {
+		r.walk(reflect.ValueOf(l).Elem(), "", map[uintptr]bool{})
+	}
+	return r
+}
+
+func (r *LangResolver) walk(v reflect.Value, inherited string, visited map[uintptr]bool) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || visited[v.Pointer()] {
+			return
+		}
+		visited[v.Pointer()] = true
+		r.walk(v.Elem(), inherited, visited)
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			r.walk(v.Index(i), inherited, visited)
+		}
+	case reflect.Struct:
+		if f, ok := v.Type().FieldByName("Lang"); ok && len(f.Index) == 1 && f.Type == languageType {
+			if lang := strings.TrimSpace(v.Field(f.Index[0]).String()); lang != "" {
+				inherited = lang
+			}
+			if v.CanAddr() {
+				r.langs[v.Addr().Interface()] = inherited
+			}
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				r.walk(v.Field(i), inherited, visited)
+			}
+		}
+	}
+}
+
+// Returns the effective language of a node of the record, such as a *Text,
+// *Term or *AppellationValue, or Undetermined if it has none. Nodes outside
+// the record only have their own xml:lang.
+func (r *LangResolver) Lang(node interface{}) string {
+	if lang, ok := r.langs[node]; ok && lang != "" {
+		return lang
+	}
+	v := reflect.ValueOf(node)
+	if v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Struct {
+		if f := v.Elem().FieldByName("Lang"); f.IsValid() && f.Type() == languageType && f.String() != "" {
+			return f.String()
+		}
+	}
+	return Undetermined
+}
+
+// Like Lang, but returns "" for undetermined nodes, for formats that leave
+// out the language of such values.
+func (r *LangResolver) Tag(node interface{}) string {
+	if lang := r.Lang(node); lang != Undetermined {
+		return lang
+	}
+	return ""
+}
+
+// Returns the text that best matches the priority list, or nil if there is
+// none.
+func (r *LangResolver) Text(p LangPriority, texts ...*Text) *Text {
+	c := &choice{priority: p}
+	for _, t := range texts {
+		if t != nil {
+			c.consider(t, string(t.Value), r.Lang(t), "")
+		}
+	}
+	best, _ := c.node.(*Text)
+	return best
+}
+
+// Returns the term of the concept that best matches the priority list,
+// leaving out added search terms, or nil if there is none.
+func (r *LangResolver) Term(p LangPriority, concept *Concept) *Term {
+	c := &choice{priority: p}
+	if concept != nil {
+		for _, t := range concept.Terms {
+			if t != nil && t.AddedSearchTerm != "yes" {
+				c.consider(t, string(t.Value), r.Lang(t), string(t.Pref))
+			}
+		}
+	}
+	best, _ := c.node.(*Term)
+	return best
+}
+
+// Returns the value of the appellations, e.g. the nameActorSets of an actor,
+// that best matches the priority list, or nil if there is none.
+func (r *LangResolver) Value(p LangPriority, appellations ...*Appellation) *AppellationValue {
+	c := &choice{priority: p}
+	for _, a := range appellations {
+		if a == nil {
+			continue
+		}
+		for _, v := range a.Values {
+			if v != nil {
+				c.consider(v, string(v.Value), r.Lang(v), string(v.Pref))
+			}
+		}
+	}
+	best, _ := c.node.(*AppellationValue)
+	return best
+}
@@ -0,0 +1,91 @@
+package lido
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLangPriority(t *testing.T) {
+	tests := map[string]LangPriority{
+		"":                                  nil,
+		"de-CH, de, en":                     {"de-CH", "de", "en"},
+		"en;q=0.5, de-CH, fr;q=0, de;q=0.9": {"de-CH", "de", "en"},
+		" en ,, *;q=0.1":                    {"en", "*"},
+	}
+	for s, want := range tests {
+		if got := ParseLangPriority(s); !reflect.DeepEqual(got, want) {
+			t.Errorf("ParseLangPriority(%q) = %q, want %q", s, got, want)
+		}
+	}
+
+	p := ParseLangPriority("de-CH, en")
+	for lang, want := range map[string]bool{"de-CH": true, "de-ch": true, "de": true, "de-AT": false, "en-GB": true, "fr": false, "": false} {
+		if got := p.Matches(lang); got != want {
+			t.Errorf("Matches(%q) = %v, want %v", lang, got, want)
+		}
+	}
+	if p.rank("de-CH") >= p.rank("de") || p.rank("de") >= p.rank("en") || p.rank("en") >= p.rank("und") || p.rank("und") >= p.rank("fr") {
+		t.Errorf("unexpected ranks")
+	}
+}
+
+func TestLangResolver(t *testing.T) {
+	l := &Lido{}
+	de := l.CreateDesc("de")
+	de.AddTitle("Der Frühling", "", true, "")
+	de.ObjectID.TitleWrap.Titles[0].Append("Frühlig", "gsw-CH", false)
+	de.ObjectID.TitleWrap.Titles[0].Append("Spring", "en", true)
+	de.AddWorkType(NewURIConcept("http://vocab.getty.edu/aat/300033618", "Gemälde", ""))
+	de.ObjectClass.WorkType.Types[0].Terms = append(de.ObjectClass.WorkType.Types[0].Terms,
+		&Term{Value: "Malerei", Lang: "de-CH"}, &Term{Value: "painting", Lang: "en", AddedSearchTerm: "yes"})
+	am := l.CreateAdmin("en")
+	rights := am.AddRights(nil, "Uffizi Gallery", "", "")
+	rights.CreditLines = append(rights.CreditLines, NewText("Galleria degli Uffizi", "it"))
+	orphan := NewText("loose", "")
+
+	r := NewLangResolver(l)
+	values := de.ObjectID.TitleWrap.Titles[0].Values
+	langs := map[interface{}]string{
+		values[0]:              "de",
+		values[1]:              "gsw-CH",
+		rights.CreditLines[0]:  "en",
+		rights.CreditLines[1]:  "it",
+		orphan:                 Undetermined,
+		NewText("loose", "fr"): "fr",
+		de.ObjectClass.WorkType.Types[0].Terms[0]: "de",
+	}
+	for node, want := range langs {
+		if got := r.Lang(node); got != want {
+			t.Errorf("Lang(%+v) = %q, want %q", node, got, want)
+		}
+	}
+
+	titles := de.ObjectID.TitleWrap.Titles[0].Appellation
+	for list, want := range map[string]string{"de-CH, de": "Der Frühling", "gsw": "Frühlig", "en-US": "Spring", "fr": "Der Frühling", "*": "Der Frühling"} {
+		if got := r.Value(ParseLangPriority(list), &titles); got == nil || string(got.Value) != want {
+			t.Errorf("Value(%q) = %+v, want %q", list, got, want)
+		}
+	}
+	concept := &de.ObjectClass.WorkType.Types[0].Concept
+	for list, want := range map[string]string{"de-CH": "Malerei", "de-AT, en": "Gemälde", "en": "Gemälde"} {
+		if got := r.Term(ParseLangPriority(list), concept); got == nil || string(got.Value) != want {
+			t.Errorf("Term(%q) = %+v, want %q", list, got, want)
+		}
+	}
+	if got := r.Text(ParseLangPriority("it, en"), rights.CreditLines...); got != rights.CreditLines[1] {
+		t.Errorf("Text = %+v", got)
+	}
+	if got := r.Text(ParseLangPriority("fr"), orphan, NewText("fr", "fr")); got == orphan {
+		t.Errorf("undetermined text beat a matching one")
+	}
+	if got := r.Text(ParseLangPriority("de"), orphan, NewText("en", "en")); got != orphan {
+		t.Errorf("undetermined text did not beat an unmatched one: %+v", got)
+	}
+	if r.Term(nil, nil) != nil || r.Text(nil) != nil || r.Value(nil) != nil {
+		t.Errorf("picked from nothing")
+	}
+
+	if got := l.PreferredTitle("de-CH, en"); got != "Der Frühling" {
+		t.Errorf("PreferredTitle = %q", got)
+	}
+}
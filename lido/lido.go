@@ -8,6 +8,9 @@ import (
 	"time"
 )
 
+// The namespace of the LIDO schema.
+const Namespace = "http://www.lido-schema.org"
+
 const LocalRecordType = "local"
 const URIType = "URI"
 const Preferred = "preferred"
@@ -27,34 +30,44 @@ func ToPref(pref bool) xsdt.String {
 	}
 }
 
-// Convenience for converting lang string to xsdt.Language, no checking
+// Convenience for converting lang string to xsdt.Language, no checking; use ParseLang for untrusted input
 func ToLang(lang string) xsdt.Language {
 	return xsdt.Language(lang)
 }
 
+// Converts lang string to xsdt.Language, returning an error if it is not a valid BCP 47 tag such as "en" or "de-CH"
+func ParseLang(lang string) (xsdt.Language, error) {
+	var l xsdt.Language
+	l.Set(lang)
+	if err := l.Validate(); err != nil {
+		return "", err
+	}
+	return l, nil
+}
+
 // Convenience for converting from string to xsdt.String
 func ToXsdt(text string) xsdt.String {
 	return xsdt.String(text)
 }
 
 type Lido struct {
-	XMLName xml.Name `xml:"http://www.lido-schema.org lido"`
+	XMLName xml.Name `xml:"http://www.lido-schema.org lido" json:"-"`
 
-	UsesLido string `xml:"xmlns:lido,attr,omitempty"`
+	UsesLido string `xml:"xmlns:lido,attr,omitempty" json:"-"`
 
 	// Attr{Name: xml.Name{"xmlns", "ns"}, Value: "http://example.com/ns"}
 
 	// A unique lido record identification preferably composed of an
 	// identifier for the contributor and a record identification in the
 	// contributor's (local) system.
-	LidoRecIDs []*Identifier `xml:"http://www.lido-schema.org lidoRecID"`
+	LidoRecIDs []*Identifier `xml:"http://www.lido-schema.org lidoRecID" json:"lidoRecID,omitempty"`
 
 	// Definition: A unique, published identification of the described object /
 	// work. May link to authority files maintained outside of the contributor's
 	// documentation system or may be an identifier for the object published by
 	// its repository, e.g. composed of an identifier for the repository and an
 	// inventory number of the object.Preferably a dereferenceable URL.
-	ObjectPublishedIDs []*Identifier `xml:"http://www.lido-schema.org objectPublishedID"`
+	ObjectPublishedIDs []*Identifier `xml:"http://www.lido-schema.org objectPublishedID" json:"objectPublishedID,omitempty"`
 
 	// Definition: Indicates the category of which this item is an instance,
 	// preferably referring to CIDOC-CRM concept definitions. CIDOC-CRM concept
@@ -63,7 +76,7 @@ type Lido struct {
 	// conceptID "http://www.cidoc-crm.org/crm-concepts/E22"), Man-Made Feature
 	// (http://www.cidoc-crm.org/crm-concepts/E25), Collection
 	// (http://www.cidoc-crm.org/crm-concepts/E78).
-	Category *Concept `xml:"http://www.lido-schema.org category"`
+	Category *Concept `xml:"http://www.lido-schema.org category" json:"category,omitempty"`
 
 	// Holds the descriptive metadata of an object record. The attribute xml:lang
 	// is mandatory and specifies the language of the descriptive metadata.For
@@ -71,7 +84,7 @@ type Lido struct {
 	// represented.If only a few data fields (e.g. title) are provided in more
 	// than one language, the respective text elements may be repeated specifying
 	// the lang attribute on the text level.
-	DescriptiveMetadatas []*DescriptiveMetadata `xml:"http://www.lido-schema.org descriptiveMetadata"`
+	DescriptiveMetadatas []*DescriptiveMetadata `xml:"http://www.lido-schema.org descriptiveMetadata" json:"descriptiveMetadata,omitempty"`
 
 	// Holds the administrative metadata for an object / work record. The
 	// attribute xml:lang is mandatory and specifies the language of the
@@ -80,12 +93,12 @@ type Lido struct {
 	// title, creditline) are provided in more than one language, the respective
 	// text elements may be repeated specifying the lang attribute on the text
 	// level.
-	AdministrativeMetadatas []*AdministrativeMetadata `xml:"http://www.lido-schema.org administrativeMetadata"`
+	AdministrativeMetadatas []*AdministrativeMetadata `xml:"http://www.lido-schema.org administrativeMetadata" json:"administrativeMetadata,omitempty"`
 
 	// Indicates the format of the data source from which the data were migrated.
 	// For each sub-element with data values then the related source data fields
 	// can be referenced through the attributes encodinganalog and label.
-	RelatedEncoding xsdt.String `xml:"http://www.lido-schema.org relatedencoding,attr,omitempty"`
+	RelatedEncoding xsdt.String `xml:"http://www.lido-schema.org relatedencoding,attr,omitempty" json:"relatedencoding,omitempty"`
 }
 
 // Append a record ID to a lido document. A record id is a unique record
@@ -131,6 +144,26 @@ func (l *Lido) CreateDesc(lang string) *DescriptiveMetadata {
 	return metadata
 }
 
+// Gets or creates an administrative metadata element for the lido file. Like
+// the descriptive metadata, these elements are only supposed to be repeated
+// for language variants, so if a matching language already exists, that
+// element is returned.
+func (l *Lido) CreateAdmin(lang string) *AdministrativeMetadata {
+	xsdtLang := ToLang(lang)
+	for _, metadata := range l.AdministrativeMetadatas {
+		if metadata.Lang == xsdtLang {
+			return metadata
+		}
+	}
+
+	metadata := &AdministrativeMetadata{
+		Lang: xsdtLang,
+	}
+
+	l.AdministrativeMetadatas = append(l.AdministrativeMetadatas, metadata)
+	return metadata
+}
+
 // Identifier is a unique identifier for the concept. Preferably taken from and
 // linking to a published controlled vocabulary. How to record: There is no
 // controlled list of identifier types. Suggested values include, but are not
@@ -141,32 +174,32 @@ func (l *Lido) CreateDesc(lang string) *DescriptiveMetadata {
 // (Persistent Uniform Resource Locator)url (Uniform Resource Locator)urn
 // (Uniform Resource Name)
 type Identifier struct {
-	Value xsdt.String `xml:",chardata"`
+	Value xsdt.String `xml:",chardata" json:"value,omitempty"`
 
 	// Definition: Qualifies the value as a preferred or alternative variant.
 	// How to record: Data values: preferred, alternate
-	Pref xsdt.String `xml:"http://www.lido-schema.org pref,attr,omitempty"`
+	Pref xsdt.String `xml:"http://www.lido-schema.org pref,attr,omitempty" json:"pref,omitempty"`
 
 	// Source of the information given in the holding element.
-	Source xsdt.String `xml:"http://www.lido-schema.org source,attr,omitempty"`
+	Source xsdt.String `xml:"http://www.lido-schema.org source,attr,omitempty" json:"source,omitempty"`
 
 	// Definition: Qualifies the type of information given in the holding element.
 	// How to record: Will generally have to be populated with a given value list.
-	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty" json:"type,omitempty"`
 
 	// How to record: Elements with data values are accompanied by the attributes
 	// encodinganalog and label to indicate the format of the data source from
 	// which the data were migrated. The attribute encodinganalog refers to the
 	// internal field label of the source database. The source format is indicated
 	// in the attribute relatedencoding of the lidoWrap
-	EncodingAnalog xsdt.String `xml:"http://www.lido-schema.org encodinganalog,attr,omitempty"`
+	EncodingAnalog xsdt.String `xml:"http://www.lido-schema.org encodinganalog,attr,omitempty" json:"encodinganalog,omitempty"`
 
 	// How to record: Elements with data values are accompanied by the attributes
 	// encodinganalog and label, to indicate the format of the data source from
 	// which the data were migrated. The attribute label refers to the external
 	// label of a data field at the visible user interface. The source format is
 	// indicated in the attribute
-	Label xsdt.String `xml:"http://www.lido-schema.org label,attr,omitempty"`
+	Label xsdt.String `xml:"http://www.lido-schema.org label,attr,omitempty" json:"label,omitempty"`
 }
 
 // Holds the descriptive metadata of an object record. The attribute xml:lang is
@@ -181,21 +214,21 @@ type DescriptiveMetadata struct {
 	// genre, form, age, sex, and phase, or by how holding organization structures
 	// its collection (e.g. fine art, decorative art, prints and drawings, natural
 	// science, numismatics, or local history).
-	ObjectClass ObjectClassification `xml:"http://www.lido-schema.org objectClassificationWrap"`
+	ObjectClass ObjectClassification `xml:"http://www.lido-schema.org objectClassificationWrap" json:"objectClassificationWrap,omitempty"`
 
 	// A Wrapper for information that identifies the object.
-	ObjectID ObjectIdentification `xml:"http://www.lido-schema.org objectIdentificationWrap"`
+	ObjectID ObjectIdentification `xml:"http://www.lido-schema.org objectIdentificationWrap" json:"objectIdentificationWrap,omitempty"`
 
 	// Wrapper for event sets.
-	EventWrap *EventWrap `xml:"http://www.lido-schema.org eventWrap"`
+	EventWrap *EventWrap `xml:"http://www.lido-schema.org eventWrap" json:"eventWrap,omitempty"`
 
 	// Wrapper for infomation about related topics and works, collections, etc.
 	// Notes: This includes visual contents and all associated entities the object
 	// is about.
-	ObjectRelationWrap *ObjectRelationWrap `xml:"http://www.lido-schema.org objectRelationWrap"`
+	ObjectRelationWrap *ObjectRelationWrap `xml:"http://www.lido-schema.org objectRelationWrap" json:"objectRelationWrap,omitempty"`
 
 	// Required language
-	Lang xsdt.Language `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Lang xsdt.Language `xml:"http://www.w3.org/XML/1998/namespace lang,attr" json:"lang,omitempty"`
 }
 
 // Were assuming that the language is already provided by the descriptive metadata
@@ -210,17 +243,17 @@ func (dm *DescriptiveMetadata) AppendTermWorkType(termSource string, conceptType
 }
 
 type ObjectIdentification struct {
-	TitleWrap TitleWrap `xml:"http://www.lido-schema.org titleWrap"`
+	TitleWrap TitleWrap `xml:"http://www.lido-schema.org titleWrap" json:"titleWrap,omitempty"`
 
-	InscriptionsWrap *InscriptionsWrap `xml:"http://www.lido-schema.org inscriptionsWrap"`
+	InscriptionsWrap *InscriptionsWrap `xml:"http://www.lido-schema.org inscriptionsWrap" json:"inscriptionsWrap,omitempty"`
 
-	RepositoryWrap *RepositoryWrap `xml:"http://www.lido-schema.org repositoryWrap"`
+	RepositoryWrap *RepositoryWrap `xml:"http://www.lido-schema.org repositoryWrap" json:"repositoryWrap,omitempty"`
 
-	DisplayStateEditionWrap *DisplayStateEdition `xml:"http://www.lido-schema.org displayStateEditionWrap"`
+	DisplayStateEditionWrap *DisplayStateEdition `xml:"http://www.lido-schema.org displayStateEditionWrap" json:"displayStateEditionWrap,omitempty"`
 
-	Description *ObjectDescription `xml:"http://www.lido-schema.org objectDescriptionWrap"`
+	Description *ObjectDescription `xml:"http://www.lido-schema.org objectDescriptionWrap" json:"objectDescriptionWrap,omitempty"`
 
-	MeasurementsWrap *MeasurementsWrap `xml:"http://www.lido-schema.org objectMeasurementsWrap"`
+	MeasurementsWrap *MeasurementsWrap `xml:"http://www.lido-schema.org objectMeasurementsWrap" json:"objectMeasurementsWrap,omitempty"`
 }
 
 type DisplayStateEdition struct {
@@ -228,20 +261,20 @@ type DisplayStateEdition struct {
 	// primarily for prints and other multiples Formulated according to rules.
 	// For State, include state identification and known states, as appropriate.
 	// Repeat this element only for language variants.
-	DisplayStates []*Text `xml:"http://www.lido-schema.org displayState"`
+	DisplayStates []*Text `xml:"http://www.lido-schema.org displayState" json:"displayState,omitempty"`
 
 	// A description of the edition of the object / work. Used primarily for
 	// prints and other multiples. Formulated according to rules. For Edition,
 	// include impression number, edition size, and edition number, or edition
 	// name, as appropriate.Repeat this element only for language variants.
-	DisplayEditions []*Text `xml:"http://www.lido-schema.org displayEdition"`
+	DisplayEditions []*Text `xml:"http://www.lido-schema.org displayEdition" json:"displayEdition,omitempty"`
 
 	// The published source of the state or edition information.
-	SourceStateEditions []*Text `xml:"http://www.lido-schema.org sourceStateEdition"`
+	SourceStateEditions []*Text `xml:"http://www.lido-schema.org sourceStateEdition" json:"sourceStateEdition,omitempty"`
 }
 
 type ObjectDescription struct {
-	Notes []*DescriptiveNote `xml:"http://www.lido-schema.org objectDescriptionSet"`
+	Notes []*DescriptiveNote `xml:"http://www.lido-schema.org objectDescriptionSet" json:"objectDescriptionSet,omitempty"`
 }
 
 // Wrapper for infomation about related topics and works, collections, etc.
@@ -250,17 +283,17 @@ type ObjectDescription struct {
 type ObjectRelationWrap struct {
 	// A wrapper for Subject information. This may be the visual content (e.g. the
 	// iconography of a painting) or what the object is about.
-	SubjectWrap *SubjectWrap `xml:"http://www.lido-schema.org subjectWrap"`
+	SubjectWrap *SubjectWrap `xml:"http://www.lido-schema.org subjectWrap" json:"subjectWrap,omitempty"`
 
 	// A wrapper for Related Works information.
-	RelatedWorksWrap *RelatedWorksWrap `xml:"http://www.lido-schema.org relatedWorksWrap"`
+	RelatedWorksWrap *RelatedWorksWrap `xml:"http://www.lido-schema.org relatedWorksWrap" json:"relatedWorksWrap,omitempty"`
 }
 
 // A wrapper for Related Works information.
 type RelatedWorksWrap struct {
 	// A wrapper for a object / work, group, collection, or series that is
 	// directly related to the object / work being recorded.
-	RelatedWorkSets []*RelatedWorkSet `xml:"http://www.lido-schema.org relatedWorkSet"`
+	RelatedWorkSets []*RelatedWorkSet `xml:"http://www.lido-schema.org relatedWorkSet" json:"relatedWorkSet,omitempty"`
 }
 
 // A wrapper for a object / work, group, collection, or series that is directly
@@ -277,14 +310,14 @@ type RelatedWorkSet struct {
 	// but from the point of view of the second record, the first work is the
 	// larger context for the second work). Whether or not relationships are
 	// physically reciprocal as implemented in systems is a local decision.
-	RelatedWorkRelType *Concept `xml:"http://www.lido-schema.org relatedWorkRelType"`
+	RelatedWorkRelType *Concept `xml:"http://www.lido-schema.org relatedWorkRelType" json:"relatedWorkRelType,omitempty"`
 
 	// Wrapper for the display and reference elements of a related object / work.
-	RelatedWork *ObjectSet `xml:"http://www.lido-schema.org relatedWork"`
+	RelatedWork *ObjectSet `xml:"http://www.lido-schema.org relatedWork" json:"relatedWork,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 // A wrapper for Subject information. This may be the visual content (e.g. the
@@ -296,7 +329,7 @@ type SubjectWrap struct {
 	// element. This element may also be repeated to distinguish between subjects
 	// that reflect what an object / work is *of* (description and identification)
 	// from what it is *about* (interpretation).
-	SubjectSets []*SubjectSet `xml:"http://www.lido-schema.org subjectSet"`
+	SubjectSets []*SubjectSet `xml:"http://www.lido-schema.org subjectSet" json:"subjectSet,omitempty"`
 }
 
 // Wrapper for display and index elements for one set of subject information.
@@ -309,43 +342,43 @@ type SubjectSet struct {
 	// A free-text description of the subject matter represented by/in the object
 	// / work, corresponding to the following subject element Repeat this element
 	// only for language variants.
-	DisplaySubjects []*Text `xml:"http://www.lido-schema.org displaySubject"`
+	DisplaySubjects []*Text `xml:"http://www.lido-schema.org displaySubject" json:"displaySubject,omitempty"`
 
 	// Contains sub-elements for a structured subject description. These identify,
 	// describe, and/or interpret what is depicted in and by an object / work or
 	// what it is about.
-	Subject *Subject `xml:"http://www.lido-schema.org subject"`
+	Subject *Subject `xml:"http://www.lido-schema.org subject" json:"subject,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 type Subject struct {
 	//	Definition: A place depicted in or by an object / work, or what it is about, provided as display and index elements.
-	SubjectPlaces []*PlaceSet `xml:"http://www.lido-schema.org subjectPlace"`
+	SubjectPlaces []*PlaceSet `xml:"http://www.lido-schema.org subjectPlace" json:"subjectPlace,omitempty"`
 
 	//	Definition: An object - e.g. a building or a work of art depicted in or by an object / work, or what it is about, provided as display and index elements.
-	SubjectObjects []*ThingPresent `xml:"http://www.lido-schema.org subjectObject"`
+	SubjectObjects []*ThingPresent `xml:"http://www.lido-schema.org subjectObject" json:"subjectObject,omitempty"`
 
-	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty" json:"type,omitempty"`
 
 	//	Definition: When there are multiple subjects, a term indicating the part of the object / work to which these subject terms apply.
 	//	How to record: Example values: recto, verso, side A, side B, main panel, and predella.Repeat this element only for language variants.
-	ExtentSubjects []*Text `xml:"http://www.lido-schema.org extentSubject"`
+	ExtentSubjects []*Text `xml:"http://www.lido-schema.org extentSubject" json:"extentSubject,omitempty"`
 
 	//	Definition: Provides references to concepts related to the subject of the described object / work.
 	//	How to record: May include iconography, themes from literature, or generic terms describing the material world, or topics (e.g., concepts, themes, or issues). However, references to people, dates, events, places, objects are indicated in the the respective sub-elements Subject Actor Set, Subject Date Set, Subject Event Set, Subject Place Set, and Subject Object Set.Preferably taken from a published controlled vocabulary.
-	SubjectConcepts []*ConceptElement `xml:"http://www.lido-schema.org subjectConcept"`
+	SubjectConcepts []*ConceptElement `xml:"http://www.lido-schema.org subjectConcept" json:"subjectConcept,omitempty"`
 
 	//	Definition: A person, group, or institution depicted in or by an object / work, or what it is about, provided as display and index elements.
-	SubjectActors []*SubjectActor `xml:"http://www.lido-schema.org subjectActor"`
+	SubjectActors []*SubjectActor `xml:"http://www.lido-schema.org subjectActor" json:"subjectActor,omitempty"`
 
 	//	Definition: A time specification depicted in or by an object / work, or what it is about, provided as display and index elements.
-	SubjectDates []*DateSpan `xml:"http://www.lido-schema.org subjectDate"`
+	SubjectDates []*DateSpan `xml:"http://www.lido-schema.org subjectDate" json:"subjectDate,omitempty"`
 
 	//	Definition: An event depicted in or by an object / work, or what it is about, provided as display and index elements.
-	SubjectEvents []*EventElement `xml:"http://www.lido-schema.org subjectEvent"`
+	SubjectEvents []*EventElement `xml:"http://www.lido-schema.org subjectEvent" json:"subjectEvent,omitempty"`
 }
 
 type SubjectActor struct {
@@ -358,95 +391,95 @@ type SubjectActor struct {
 	// is acceptable. Include nationality and life dates. For unknown actors, use
 	// e.g.: "unknown," "unknown Chinese," "Chinese," or "unknown 15th century
 	// Chinese." Repeat this element only for language variants.
-	DisplayActors []*Text `xml:"http://www.lido-schema.org displayActor"`
+	DisplayActors []*Text `xml:"http://www.lido-schema.org displayActor" json:"displayActor,omitempty"`
 
 	// Describes and identifies an actor, i.e. a person, corporation, family or
 	// group, containing structured sub-elements for indexing and identification
 	// references.
-	Actor *Actor `xml:"http://www.lido-schema.org actor"`
+	Actor *Actor `xml:"http://www.lido-schema.org actor" json:"actor,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 type ObjectClassification struct {
 	// A wrapper for Object/Work Types.
-	WorkType ObjectWorkTypeWrap `xml:"http://www.lido-schema.org objectWorkTypeWrap"`
+	WorkType ObjectWorkTypeWrap `xml:"http://www.lido-schema.org objectWorkTypeWrap" json:"objectWorkTypeWrap,omitempty"`
 
 	// A wrapper for any classification used to categorize an object / work by
 	// grouping it together with others on the basis of similar characteristics.
-	ClassificationWrap *ClassificationWrap `xml:"http://www.lido-schema.org classificationWrap"`
+	ClassificationWrap *ClassificationWrap `xml:"http://www.lido-schema.org classificationWrap" json:"classificationWrap,omitempty"`
 }
 
 type ObjectWorkTypeWrap struct {
-	Types []*ClassificationElement `xml:"http://www.lido-schema.org objectWorkType"`
+	Types []*ClassificationElement `xml:"http://www.lido-schema.org objectWorkType" json:"objectWorkType,omitempty"`
 }
 
 type ClassificationWrap struct {
-	Classifications []*ClassificationElement `xml:"http://www.lido-schema.org classification"`
+	Classifications []*ClassificationElement `xml:"http://www.lido-schema.org classification" json:"classification,omitempty"`
 }
 
 type LegalBodyRef struct {
 	//	Definition: Unambiguous identification of the institution or person referred to as legal body.
-	LegalBodyIDs []*Identifier `xml:"http://www.lido-schema.org legalBodyID"`
+	LegalBodyIDs []*Identifier `xml:"http://www.lido-schema.org legalBodyID" json:"legalBodyID,omitempty"`
 
 	//	Definition: Appellation of the institution or person.
-	LegalBodyNames []*Appellation `xml:"http://www.lido-schema.org legalBodyName"`
+	LegalBodyNames []*Appellation `xml:"http://www.lido-schema.org legalBodyName" json:"legalBodyName,omitempty"`
 
 	//	Definition: Weblink of the institution or person referred to as legal body.
-	LegalBodyWeblinks []*WebResource `xml:"http://www.lido-schema.org legalBodyWeblink"`
+	LegalBodyWeblinks []*WebResource `xml:"http://www.lido-schema.org legalBodyWeblink" json:"legalBodyWeblink,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 
-	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty" json:"type,omitempty"`
 }
 
 type AdministrativeMetadata struct {
 	// Wrapper for rights information about the object / work described.
 	// Notes: Rights information for the record and for resources is recorded in
 	// the respective rights elements recordRights and rightsResource.
-	RightsWorkWrap *RightsWorkWrap `xml:"http://www.lido-schema.org rightsWorkWrap"`
+	RightsWorkWrap *RightsWorkWrap `xml:"http://www.lido-schema.org rightsWorkWrap" json:"rightsWorkWrap,omitempty"`
 
 	// A wrapper for information about the record that contains the cataloguing
 	// information. Note that this section does not refer to any object or
 	// resource information, but only to the source record.
-	RecordWrap *RecordWrap `xml:"http://www.lido-schema.org recordWrap"`
+	RecordWrap *RecordWrap `xml:"http://www.lido-schema.org recordWrap" json:"recordWrap,omitempty"`
 
 	// A wrapper for resources that are surrogates for an object / work, including
 	// digital images, videos or audio files that represent it in an online
 	// service.
-	ResourceWrap *ResourceWrap `xml:"http://www.lido-schema.org resourceWrap"`
+	ResourceWrap *ResourceWrap `xml:"http://www.lido-schema.org resourceWrap" json:"resourceWrap,omitempty"`
 
-	Lang xsdt.Language `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Lang xsdt.Language `xml:"http://www.w3.org/XML/1998/namespace lang,attr" json:"lang,omitempty"`
 }
 
 type RightsWorkWrap struct {
-	RightsWorkSets []*Rights `xml:"http://www.lido-schema.org rightsWorkSet"`
+	RightsWorkSets []*Rights `xml:"http://www.lido-schema.org rightsWorkSet" json:"rightsWorkSet,omitempty"`
 }
 
 type RecordWrap struct {
 	// A unique record identification in the contributor's (local) system.
-	RecordIDs []*Identifier `xml:"http://www.lido-schema.org recordID"`
+	RecordIDs []*Identifier `xml:"http://www.lido-schema.org recordID" json:"recordID,omitempty"`
 
 	// Term establishing whether the record represents an individual item or a
 	// collection, series, or group of works. Mandatory. Example values: item,
 	// collection, series, group, volume, fonds. Preferably taken from a published
 	// controlled value list.
-	RecordType *Concept `xml:"http://www.lido-schema.org recordType"`
+	RecordType *Concept `xml:"http://www.lido-schema.org recordType" json:"recordType,omitempty"`
 
 	// The source of information in this record, generally the repository or other
 	// institution.
-	RecordSources []*LegalBodyRef `xml:"http://www.lido-schema.org recordSource"`
+	RecordSources []*LegalBodyRef `xml:"http://www.lido-schema.org recordSource" json:"recordSource,omitempty"`
 
 	// Information about rights regarding the content provided in this LIDO
 	// record.
-	RecordRights []*Rights `xml:"http://www.lido-schema.org recordRights"`
+	RecordRights []*Rights `xml:"http://www.lido-schema.org recordRights" json:"recordRights,omitempty"`
 
 	// Wrapper for metadata information about this record.
-	RecordInfoSets []*RecordInfo `xml:"http://www.lido-schema.org recordInfoSet"`
+	RecordInfoSets []*RecordInfo `xml:"http://www.lido-schema.org recordInfoSet" json:"recordInfoSet,omitempty"`
 }
 
 type RecordInfo struct {
@@ -455,23 +488,23 @@ type RecordInfo struct {
 	// ID but out of the context of original local system, such as a persistent
 	// identifier or an oai identifier (e.g., oai1:getty.edu:paintings/00001234
 	// attribute type= oai).
-	RecordInfoIDs []*Identifier `xml:"http://www.lido-schema.org recordInfoID"`
+	RecordInfoIDs []*Identifier `xml:"http://www.lido-schema.org recordInfoID" json:"recordInfoID,omitempty"`
 
 	// Link of the metadata, e.g., to the object data sheet (not the same as link
 	// of the object).
-	RecordInfoLinks []*WebResource `xml:"http://www.lido-schema.org recordInfoLink"`
+	RecordInfoLinks []*WebResource `xml:"http://www.lido-schema.org recordInfoLink" json:"recordInfoLink,omitempty"`
 
 	// Creation date or date modified of the metadata record. Format will vary
 	// depending upon implementation.
-	RecordMetadataDates []*Note `xml:"http://www.lido-schema.org recordMetadataDate"`
+	RecordMetadataDates []*Note `xml:"http://www.lido-schema.org recordMetadataDate" json:"recordMetadataDate,omitempty"`
 
-	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty" json:"type,omitempty"`
 }
 
 type ResourceWrap struct {
 	//	Definition: Contains sub-elements for a structured resource description.
 	//	Notes: Provides identification of a surrogate of the object / work including digital images, slides, transparencies, photographs, audio, video and moving images, but excluding items that are considered object / works in their own right. For such as drawings, prints, paintings, or photographs considered art, and other works that themselves contain representations of other works, use Related Works and/or Subjects.
-	ResourceSets []*ResourceSet `xml:"http://www.lido-schema.org resourceSet"`
+	ResourceSets []*ResourceSet `xml:"http://www.lido-schema.org resourceSet" json:"resourceSet,omitempty"`
 }
 
 type ResourceSet struct {
@@ -480,18 +513,18 @@ type ResourceSet struct {
 	// differs from the holder of rights for the work. See also Rights Work above.
 	// (E.g., the work rights are " National Museum of African Art, Smithsonian
 	// Instituition (Washing DC), " but the image rights are "Photo Frank Khoury.")
-	RightsResources []*Rights `xml:"http://www.lido-schema.org rightsResource"`
+	RightsResources []*Rights `xml:"http://www.lido-schema.org rightsResource" json:"rightsResource,omitempty"`
 
 	// A digital representation of a resource for online presentation. Repeat this
 	// element set for variants representing the same resource, e.g. different
 	// sizes of the same image, or a thumbnail representing an audio or video file
 	// and the digital audio or video file itself.
-	ResourceRepresentations []*ResourceRep `xml:"http://www.lido-schema.org resourceRepresentation"`
+	ResourceRepresentations []*ResourceRep `xml:"http://www.lido-schema.org resourceRepresentation" json:"resourceRepresentation,omitempty"`
 
 	// The generic identification of the medium of the image or other resource.
 	// Preferably using a controlled published value list. Example values: digital
 	// image, photograph, slide, videotape, X-ray photograph, negative.
-	ResourceType *Concept `xml:"http://www.lido-schema.org resourceType"`
+	ResourceType *Concept `xml:"http://www.lido-schema.org resourceType" json:"resourceType,omitempty"`
 
 	// A date or range of dates associated with the creation or production of the
 	// original resource, e.g. the image or recording.
@@ -499,74 +532,74 @@ type ResourceSet struct {
 	// digital resource (e.g. a digitization of a negative is usually made years
 	// after the image was captured on film). Format will vary depending upon
 	// implementation.
-	ResourceDateTaken *DateSet `xml:"http://www.lido-schema.org resourceDateTaken"`
+	ResourceDateTaken *DateSet `xml:"http://www.lido-schema.org resourceDateTaken" json:"resourceDateTaken,omitempty"`
 
 	// Identification of the agency, individual, or repository from which the
 	// image or other resource was obtained. Include this sub-element when the
 	// source of the image/resource differs from the source named in Record Source.
-	ResourceSources []*LegalBodyRef `xml:"http://www.lido-schema.org resourceSource"`
+	ResourceSources []*LegalBodyRef `xml:"http://www.lido-schema.org resourceSource" json:"resourceSource,omitempty"`
 
 	// The unique numeric or alphanumeric identification of the original (digital
 	// or analogue) resource.
-	ResourceID *Identifier `xml:"http://www.lido-schema.org resourceID"`
+	ResourceID *Identifier `xml:"http://www.lido-schema.org resourceID" json:"resourceID,omitempty"`
 
 	// The relationship of the resource to the object / work being described.
 	// Example values: conservation image, documentary image, contextual image,
 	// historical image, reconstruction, and installation image
-	ResourceRelTypes []*Concept `xml:"http://www.lido-schema.org resourceRelType"`
+	ResourceRelTypes []*Concept `xml:"http://www.lido-schema.org resourceRelType" json:"resourceRelType,omitempty"`
 
 	// The specific vantage point or perspective of the view.
-	ResourcePerspectives []*Concept `xml:"http://www.lido-schema.org resourcePerspective"`
+	ResourcePerspectives []*Concept `xml:"http://www.lido-schema.org resourcePerspective" json:"resourcePerspective,omitempty"`
 
 	// A description of the spatial, chronological, or contextual aspects of the
 	// object / work as captured in this particular resource.
-	ResourceDescriptions []*Note `xml:"http://www.lido-schema.org resourceDescription"`
+	ResourceDescriptions []*Note `xml:"http://www.lido-schema.org resourceDescription" json:"resourceDescription,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 type Rights struct {
 	//	Definition: The specific type of right being recorded.
 	//	How to record: For example: copyright, publication right, data protection right, trademark.Preferably taken from a published controlled value list.
-	RightsTypes []*Concept `xml:"http://www.lido-schema.org rightsType"`
+	RightsTypes []*Concept `xml:"http://www.lido-schema.org rightsType" json:"rightsType,omitempty"`
 
 	//	Definition: The date on which a right is or was current.
-	RightsDate *DateSpan `xml:"http://www.lido-schema.org rightsDate"`
+	RightsDate *DateSpan `xml:"http://www.lido-schema.org rightsDate" json:"rightsDate,omitempty"`
 
 	//	Definition: The holder of the right.
-	RightsHolders []*LegalBodyRef `xml:"http://www.lido-schema.org rightsHolder"`
+	RightsHolders []*LegalBodyRef `xml:"http://www.lido-schema.org rightsHolder" json:"rightsHolder,omitempty"`
 
 	//	Definition: Acknowledgement of the rights associated with the physical and/or digital object as requested.
 	//	How to record: Repeat this element only for language variants.
-	CreditLines []*Text `xml:"http://www.lido-schema.org creditLine"`
+	CreditLines []*Text `xml:"http://www.lido-schema.org creditLine" json:"creditLine,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 type ResourceRep struct {
 	//	Definition: A url reference in the worldwide web environment.
-	LinkResource *LinkResource `xml:"http://www.lido-schema.org linkResource"`
+	LinkResource *LinkResource `xml:"http://www.lido-schema.org linkResource" json:"linkResource,omitempty"`
 
 	//	Definition: Any technical measurement information needed for online presentation of the resource.
 	//	How to record: For images provide width and height of the digital image, for audio or video resources provide duration, bit rate, frame size, and if necessary TC-IN, TC-OUT.
-	ResourceMeasurementsSets []*MeasurementsSet `xml:"http://www.lido-schema.org resourceMeasurementsSet"`
+	ResourceMeasurementsSets []*MeasurementsSet `xml:"http://www.lido-schema.org resourceMeasurementsSet" json:"resourceMeasurementsSet,omitempty"`
 
-	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty" json:"type,omitempty"`
 }
 
 type LinkResource struct {
 	WebResource
 
 	//	Definition: Codec information about the digital resource.
-	CodecResource xsdt.String `xml:"http://www.lido-schema.org codecResource,attr"`
+	CodecResource xsdt.String `xml:"http://www.lido-schema.org codecResource,attr" json:"codecResource,omitempty"`
 }
 
 type EventWrap struct {
-	Events []*EventElement `xml:"http://www.lido-schema.org eventSet"`
+	Events []*EventElement `xml:"http://www.lido-schema.org eventSet" json:"eventSet,omitempty"`
 }
 
 func (ew *EventWrap) AppendEvent(event *Event) {
@@ -578,133 +611,133 @@ func (ew *EventWrap) AppendEvent(event *Event) {
 }
 
 type WorkID struct {
-	XsdtString xsdt.String `xml:",chardata"`
+	XsdtString xsdt.String `xml:",chardata" json:"value,omitempty"`
 	// How to record: Elements with data values are accompanied by the attributes
 	// encodinganalog and label to indicate the format of the data source from
 	// which the data were migrated. The attribute encodinganalog refers to the
 	// internal field label of the source database. The source format is indicated
 	// in the attribute relatedencoding of the lidoWrap
-	EncodingAnalog xsdt.String `xml:"http://www.lido-schema.org encodinganalog,attr,omitempty"`
+	EncodingAnalog xsdt.String `xml:"http://www.lido-schema.org encodinganalog,attr,omitempty" json:"encodinganalog,omitempty"`
 
 	// How to record: Elements with data values are accompanied by the attributes
 	// encodinganalog and label, to indicate the format of the data source from
 	// which the data were migrated. The attribute label refers to the external
 	// label of a data field at the visible user interface. The source format is
 	// indicated in the attribute
-	Label xsdt.String `xml:"http://www.lido-schema.org label,attr,omitempty"`
+	Label xsdt.String `xml:"http://www.lido-schema.org label,attr,omitempty" json:"label,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 
 	// Specification of the date, e.g. if it is an exact or an estimated earliest
 	// date. Data values may be: exactDate, estimatedDate.
-	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty" json:"type,omitempty"`
 }
 
 type EventElement struct {
 	// Display element for an event, corresponding to the following event element.
 	// How to record: Repeat this element only for language variants.
-	DisplayEvents []*Text `xml:"http://www.lido-schema.org displayEvent"`
+	DisplayEvents []*Text `xml:"http://www.lido-schema.org displayEvent" json:"displayEvent,omitempty"`
 
 	// Identifying, descriptive and indexing information for the events in which
 	// the object participated or was present at, e.g. creation, excavation,
 	// collection, and use. All information related to the creation of an object:
 	// creator, cutlural context, creation date, creation place, the material and
 	// techniques used are recorded here, qualified by the event type “creation”.
-	Event *Event `xml:"http://www.lido-schema.org event"`
+	Event *Event `xml:"http://www.lido-schema.org event" json:"event,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 // Simple text element with encodinganalog and label attribute
 type Text struct {
-	Value xsdt.String `xml:",chardata"`
+	Value xsdt.String `xml:",chardata" json:"value,omitempty"`
 
-	Lang xsdt.Language `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	Lang xsdt.Language `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty" json:"lang,omitempty"`
 
 	// How to record: Elements with data values are accompanied by the attributes
 	// encodinganalog and label to indicate the format of the data source from
 	// which the data were migrated. The attribute encodinganalog refers to the
 	// internal field label of the source database. The source format is indicated
 	// in the attribute relatedencoding of the lidoWrap
-	EncodingAnalog xsdt.String `xml:"http://www.lido-schema.org encodinganalog,attr,omitempty"`
+	EncodingAnalog xsdt.String `xml:"http://www.lido-schema.org encodinganalog,attr,omitempty" json:"encodinganalog,omitempty"`
 
 	// How to record: Elements with data values are accompanied by the attributes
 	// encodinganalog and label, to indicate the format of the data source from
 	// which the data were migrated. The attribute label refers to the external
 	// label of a data field at the visible user interface. The source format is
 	// indicated in the attribute
-	Label xsdt.String `xml:"http://www.lido-schema.org label,attr,omitempty"`
+	Label xsdt.String `xml:"http://www.lido-schema.org label,attr,omitempty" json:"label,omitempty"`
 }
 
 type Event struct {
 	//	Definition: A unique identifier for the event.
 	//	How to record: Preferably taken from and linking to a published resource describing the event.
-	EventIDs []*Identifier `xml:"http://www.lido-schema.org eventID"`
+	EventIDs []*Identifier `xml:"http://www.lido-schema.org eventID" json:"eventID,omitempty"`
 
 	//	Definition: The nature of the event associated with an object / work.
 	//	How to record: Controlled. Recommended: Defined list of subclasses of CRM entity E5 Event.Basic event types as recorded in sub-element term include: Acquisition, Collecting, Commisioning, Creation, Designing, Destruction, Event (non-specified), Excavation, Exhibition, Finding, Loss, Modification, Move, Part addition, Part removal, Performance, Planning, Production, Provenance, Publication, Restoration, Transformation, Type assignment, Type creation, Use.
-	EventTypes []*Concept `xml:"http://www.lido-schema.org eventType"`
+	EventTypes []*Concept `xml:"http://www.lido-schema.org eventType" json:"eventType,omitempty"`
 
 	//	Definition: Date specification of the event.
-	Date *DateSet `xml:"http://www.lido-schema.org eventDate"`
+	Date *DateSet `xml:"http://www.lido-schema.org eventDate" json:"eventDate,omitempty"`
 
 	//	Definition: Place specification of the event.
-	EventPlaces []*EventPlace `xml:"http://www.lido-schema.org eventPlace"`
+	EventPlaces []*EventPlace `xml:"http://www.lido-schema.org eventPlace" json:"eventPlace,omitempty"`
 
 	// The method by which the event is carried out. Preferably taken from a
 	// published controlled vocabulary.
 	// Notes: Used e.g. for SPECTRUM Units of Information
 	// "field collection method", "acquisition method".
-	EventMethods []*ConceptElement `xml:"http://www.lido-schema.org eventMethod"`
+	EventMethods []*ConceptElement `xml:"http://www.lido-schema.org eventMethod" json:"eventMethod,omitempty"`
 
 	// References another object that was present at this same event.
-	ThingPresents []*ThingPresent `xml:"http://www.lido-schema.org thingPresent"`
+	ThingPresents []*ThingPresent `xml:"http://www.lido-schema.org thingPresent" json:"thingPresent,omitempty"`
 
 	// Wrapper for a description of the event, including description identifer,
 	// descriptive note of the event and its sources. If there is more than one
 	// descriptive note, repeat this element.
-	EventDescriptionSets []*DescriptiveNote `xml:"http://www.lido-schema.org eventDescriptionSet"`
+	EventDescriptionSets []*DescriptiveNote `xml:"http://www.lido-schema.org eventDescriptionSet" json:"eventDescriptionSet,omitempty"`
 
 	// An appellation for the event, e.g. a title, identifying phrase, or name
 	// given to it.
-	EventNames []*Appellation `xml:"http://www.lido-schema.org eventName"`
+	EventNames []*Appellation `xml:"http://www.lido-schema.org eventName" json:"eventName,omitempty"`
 
 	// Wrapper for display and index elements for an actor with role information
 	// (participating or being present in the event). For multiple actors repeat
 	// the element.
-	EventActors []*EventActor `xml:"http://www.lido-schema.org eventActor"`
+	EventActors []*EventActor `xml:"http://www.lido-schema.org eventActor" json:"eventActor,omitempty"`
 
 	//	Definition: References an event which is linked in some way to this event, e.g. a field trip within which this object was collected.
-	RelatedEvents []*RelatedEvent `xml:"http://www.lido-schema.org relatedEventSet"`
+	RelatedEvents []*RelatedEvent `xml:"http://www.lido-schema.org relatedEventSet" json:"relatedEventSet,omitempty"`
 
 	// The role played within this event by the described entity. Preferably taken
 	// from a published controlled vocabulary.
-	RoleInEvents []*Concept `xml:"http://www.lido-schema.org roleInEvent"`
+	RoleInEvents []*Concept `xml:"http://www.lido-schema.org roleInEvent" json:"roleInEvent,omitempty"`
 
 	// Name of a culture, cultural context, people, or also a nationality.
 	// Preferably using a controlled vocabuarly.
-	Cultures []*ConceptElement `xml:"http://www.lido-schema.org culture"`
+	Cultures []*ConceptElement `xml:"http://www.lido-schema.org culture" json:"culture,omitempty"`
 
 	// A period in which the event happened. Preferably taken from a published
 	// controlled vocabulary. Repeat this element only for indicating an earliest
 	// and latest period delimiting the event.
 	// Notes: Period concepts have delimiting character in time and space.
-	PeriodNames []*ClassificationElement `xml:"http://www.lido-schema.org periodName"`
+	PeriodNames []*ClassificationElement `xml:"http://www.lido-schema.org periodName" json:"periodName,omitempty"`
 
 	// Indicates the substances or materials used within the event (e.g. the
 	// creation of an object / work), as well as any implements, production or
 	// manufacturing techniques, processes, or methods incorporated. Will be used
 	// most often within a production event, but also others such as excavation,
 	// restoration, etc.
-	EventMaterialsTechs []*EventMaterialsTech `xml:"http://www.lido-schema.org eventMaterialsTech"`
+	EventMaterialsTechs []*EventMaterialsTech `xml:"http://www.lido-schema.org eventMaterialsTech" json:"eventMaterialsTech,omitempty"`
 
 	// NOTE, below here is a modification for Verisart's internal uses, please
 	// ignore and do not use should be no side effects
-	MeasurementsWrap *MeasurementsWrap `xml:"http://www.lido-schema.org objectMeasurementsWrap"`
+	MeasurementsWrap *MeasurementsWrap `xml:"http://www.lido-schema.org objectMeasurementsWrap" json:"objectMeasurementsWrap,omitempty"`
 }
 
 // Sets the LIDO category to a category defined in the CIDOC CRM
@@ -719,6 +752,8 @@ func (e *Event) AppendCRMType(crmClass *crm.ConcreteClass) error {
 	return nil
 }
 
+// Sets the date of the event to a span of UTC timestamps. Use SetDateRange
+// for dates only known to the year, month or day.
 func (e *Event) SetDate(min time.Time, max time.Time) {
 	e.Date = &DateSet{
 		Date: &DateSpan{
@@ -738,103 +773,103 @@ type DateSet struct {
 	// syntax suitable for display to the end-user and including any necessary
 	// indications of uncertainty, ambiguity, and nuance.Repeat this element only
 	// for language variants.
-	DisplayDates []*Text `xml:"http://www.lido-schema.org displayDate"`
+	DisplayDates []*Text `xml:"http://www.lido-schema.org displayDate" json:"displayDate,omitempty"`
 
 	// Contains a date specification by providing a set of years as earliest and
 	// latest date delimiting the respective span of time.This may be a period or
 	// a set of years in the proleptic Gregorian calendar delimiting the span of
 	// time. If it is an exact date, possibly with time, repeat the same date (and
 	// time) in earliest and latest date.
-	Date *DateSpan `xml:"http://www.lido-schema.org date"`
+	Date *DateSpan `xml:"http://www.lido-schema.org date" json:"date,omitempty"`
 }
 
 type DateSpan struct {
 	//	Definition: A year or exact date that broadly delimits the beginning of an implied date span.
 	//	How to record: General format: YYYY[-MM[-DD]]Format is according to ISO 8601. This may include date and time specification.
-	EarliestDate *Date `xml:"http://www.lido-schema.org earliestDate"`
+	EarliestDate *Date `xml:"http://www.lido-schema.org earliestDate" json:"earliestDate,omitempty"`
 
 	//	Definition: A year or exact date that broadly delimits the end of an implied date span.
 	//	How to record: General format: YYYY[-MM[-DD]]Format is according to ISO 8601. This may include date and time specification.
-	LatestDate *Date `xml:"http://www.lido-schema.org latestDate"`
+	LatestDate *Date `xml:"http://www.lido-schema.org latestDate" json:"latestDate,omitempty"`
 }
 
 // A year or exact date that broadly delimits the beginning of an implied date
 // span. General format: YYYY[-MM[-DD]]Format is according to ISO 8601. This may
 // include date and time specification.
 type Date struct {
-	Value xsdt.String `xml:",chardata"`
+	Value xsdt.String `xml:",chardata" json:"value,omitempty"`
 
 	// Source of the information given in the holding element.
-	Source xsdt.String `xml:"http://www.lido-schema.org source,attr,omitempty"`
+	Source xsdt.String `xml:"http://www.lido-schema.org source,attr,omitempty" json:"source,omitempty"`
 
 	// Specification of the date, e.g. if it is an exact or an estimated earliest
 	// date. Data values may be: exactDate, estimatedDate.
-	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty" json:"type,omitempty"`
 
 	// How to record: Elements with data values are accompanied by the attributes
 	// encodinganalog and label to indicate the format of the data source from
 	// which the data were migrated. The attribute encodinganalog refers to the
 	// internal field label of the source database. The source format is indicated
 	// in the attribute relatedencoding of the lidoWrap
-	EncodingAnalog xsdt.String `xml:"http://www.lido-schema.org encodinganalog,attr,omitempty"`
+	EncodingAnalog xsdt.String `xml:"http://www.lido-schema.org encodinganalog,attr,omitempty" json:"encodinganalog,omitempty"`
 
 	// How to record: Elements with data values are accompanied by the attributes
 	// encodinganalog and label, to indicate the format of the data source from
 	// which the data were migrated. The attribute label refers to the external
 	// label of a data field at the visible user interface. The source format is
 	// indicated in the attribute
-	Label xsdt.String `xml:"http://www.lido-schema.org label,attr,omitempty"`
+	Label xsdt.String `xml:"http://www.lido-schema.org label,attr,omitempty" json:"label,omitempty"`
 }
 
 type EventPlace struct {
 	PlaceSet
 
 	//	How to record: Data values may be: moveFrom, moveTo, alternative.
-	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty" json:"type,omitempty"`
 }
 
 type PlaceSet struct {
 	//	Definition: Display element for a place specification, corresponding to the following place element.
 	//	How to record: Repeat this element only for language variants.
-	DisplayPlaces []*Text `xml:"http://www.lido-schema.org displayPlace"`
+	DisplayPlaces []*Text `xml:"http://www.lido-schema.org displayPlace" json:"displayPlace,omitempty"`
 
 	// Contains structured identifying and indexing information for a geographical
 	// entity.
-	Place *Place `xml:"http://www.lido-schema.org place"`
+	Place *Place `xml:"http://www.lido-schema.org place" json:"place,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 type Place struct {
 	//	Definition: Allows for indexing larger geographical entities.
-	PartOfPlaces []*Place `xml:"http://www.lido-schema.org partOfPlace"`
+	PartOfPlaces []*Place `xml:"http://www.lido-schema.org partOfPlace" json:"partOfPlace,omitempty"`
 
 	// A classification of the place, e.g. by geological complex, stratigraphic
 	// unit or habitat type.
-	PlaceClassifications []*PlaceClassification `xml:"http://www.lido-schema.org placeClassification"`
+	PlaceClassifications []*PlaceClassification `xml:"http://www.lido-schema.org placeClassification" json:"placeClassification,omitempty"`
 
 	//Data values can include: Gemeinde, Kreis, Bundesland, Staat, Herzogtum,
 	// city, county, country, civil parish
-	PoliticalEntity xsdt.String `xml:"http://www.lido-schema.org politicalEntity,attr"`
+	PoliticalEntity xsdt.String `xml:"http://www.lido-schema.org politicalEntity,attr" json:"politicalEntity,omitempty"`
 
 	//	Definition: Data values can include: Naturraum, Landschaft, natural environment, landscape
-	GeographicalEntity xsdt.String `xml:"http://www.lido-schema.org geographicalEntity,attr"`
+	GeographicalEntity xsdt.String `xml:"http://www.lido-schema.org geographicalEntity,attr" json:"geographicalEntity,omitempty"`
 
 	//	Definition: A unique identifier for the place.
 	//	How to record: Preferably taken from a published authority file.
-	PlaceIDs []*Identifier `xml:"http://www.lido-schema.org placeID"`
+	PlaceIDs []*Identifier `xml:"http://www.lido-schema.org placeID" json:"placeID,omitempty"`
 
 	// The name of the geographic place. If there are different names of the same
 	// place, e.g. today's and historical names, repeat this element.
-	NamePlaceSets []*Appellation `xml:"http://www.lido-schema.org namePlaceSet"`
+	NamePlaceSets []*Appellation `xml:"http://www.lido-schema.org namePlaceSet" json:"namePlaceSet,omitempty"`
 
 	// Georeferences of the place using the GML specification. Repeat this element
 	// only for language variants.
 	// Notes: For further documentation on GML refer to
 	// http://www.opengis.net/gml/.
-	GMLs []*GML `xml:"gml"`
+	GMLs []*GML `xml:"gml" json:"gml,omitempty"`
 }
 
 // A classification of the place, e.g. by geological complex, stratigraphic unit
@@ -842,19 +877,19 @@ type Place struct {
 type PlaceClassification struct {
 	Concept
 
-	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty" json:"type,omitempty"`
 }
 
 // Specifies the GML instantiation for georeferences. Notes: For documentation
 // on GML refer to http://www.opengis.net/gml/.
 type GML struct {
-	Lang xsdt.Language `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	Lang xsdt.Language `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty" json:"lang,omitempty"`
 
-	LineStrings []*gml.LineString `xml:"http://www.opengis.net/gml LineString"`
+	LineStrings []*gml.LineString `xml:"http://www.opengis.net/gml LineString" json:"LineString,omitempty"`
 
-	Polygons []*gml.Polygon `xml:"http://www.opengis.net/gml Polygon"`
+	Polygons []*gml.Polygon `xml:"http://www.opengis.net/gml Polygon" json:"Polygon,omitempty"`
 
-	Points []*gml.Point `xml:"http://www.opengis.net/gml Point"`
+	Points []*gml.Point `xml:"http://www.opengis.net/gml Point" json:"Point,omitempty"`
 }
 
 type ConceptElement struct {
@@ -862,17 +897,17 @@ type ConceptElement struct {
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 type ClassificationElement struct {
 	Concept
 
-	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty" json:"type,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 func NewConceptClassification(concept *Concept) *ClassificationElement {
@@ -886,57 +921,57 @@ type ThingPresent struct {
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 type ObjectSet struct {
 	//	Definition: A free-text description of the object, corresponding to the following object element
 	//	How to record: Repeat this element only for language variants.
-	DisplayObjects []*Text `xml:"http://www.lido-schema.org displayObject"`
+	DisplayObjects []*Text `xml:"http://www.lido-schema.org displayObject" json:"displayObject,omitempty"`
 
 	//	Definition: Contains identifying information and links to another object.
-	Object *Object `xml:"http://www.lido-schema.org object"`
+	Object *Object `xml:"http://www.lido-schema.org object" json:"object,omitempty"`
 }
 
 type Object struct {
 	// A URL-Reference to a description of the object / work in the worldwide web
 	// environment.
-	ObjectWebResources []*WebResource `xml:"http://www.lido-schema.org objectWebResource"`
+	ObjectWebResources []*WebResource `xml:"http://www.lido-schema.org objectWebResource" json:"objectWebResource,omitempty"`
 
 	//	Definition: Unique identifier of the referenced object / work.
-	ObjectIDs []*Identifier `xml:"http://www.lido-schema.org objectID"`
+	ObjectIDs []*Identifier `xml:"http://www.lido-schema.org objectID" json:"objectID,omitempty"`
 
 	//	Definition: A descriptive identification of the object / work that will be meaningful to end-users, including some or all of the following information, as necessary for clarity and if known: title, object/work type, important actor, date and/or place information, potentially location of the object / work.
 	//	How to record: The information should ideally be generated from fields/elements in the related record.
-	ObjectNotes []*Note `xml:"http://www.lido-schema.org objectNote"`
+	ObjectNotes []*Note `xml:"http://www.lido-schema.org objectNote" json:"objectNote,omitempty"`
 }
 
 type WebResource struct {
-	XsdtString xsdt.String `xml:"http://www.lido-schema.org ,chardata"`
+	XsdtString xsdt.String `xml:"http://www.lido-schema.org ,chardata" json:"value,omitempty"`
 
 	//	Definition: Indicates the internet media type, e.g. the file format of the given web resource.
 	//	How to record: Data values should be taken from the official IANA list (see http://www.iana.org/assignments/media-types/). Includes: text/html, text/xml, image/jpeg, audio/mpeg, video/mpeg, application/pdf.
-	FormatResource xsdt.String `xml:"http://www.lido-schema.org formatResource,attr"`
+	FormatResource xsdt.String `xml:"http://www.lido-schema.org formatResource,attr" json:"formatResource,omitempty"`
 
 	// How to record: Elements with data values are accompanied by the attributes
 	// encodinganalog and label to indicate the format of the data source from
 	// which the data were migrated. The attribute encodinganalog refers to the
 	// internal field label of the source database. The source format is indicated
 	// in the attribute relatedencoding of the lidoWrap
-	EncodingAnalog xsdt.String `xml:"http://www.lido-schema.org encodinganalog,attr,omitempty"`
+	EncodingAnalog xsdt.String `xml:"http://www.lido-schema.org encodinganalog,attr,omitempty" json:"encodinganalog,omitempty"`
 
 	// How to record: Elements with data values are accompanied by the attributes
 	// encodinganalog and label, to indicate the format of the data source from
 	// which the data were migrated. The attribute label refers to the external
 	// label of a data field at the visible user interface. The source format is
 	// indicated in the attribute
-	Label xsdt.String `xml:"http://www.lido-schema.org label,attr,omitempty"`
+	Label xsdt.String `xml:"http://www.lido-schema.org label,attr,omitempty" json:"label,omitempty"`
 
-	Lang xsdt.Language `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	Lang xsdt.Language `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty" json:"lang,omitempty"`
 
 	// Qualifies the value as a preferred or alternative variant. Data values:
 	// preferred, alternate
-	Pref xsdt.String `xml:"http://www.lido-schema.org pref,attr,omitempty"`
+	Pref xsdt.String `xml:"http://www.lido-schema.org pref,attr,omitempty" json:"pref,omitempty"`
 }
 
 // A descriptive identification of the object / work that will be meaningful to
@@ -950,31 +985,31 @@ type Note struct {
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 
 	// Source of the information given in the holding element.
-	Source xsdt.String `xml:"http://www.lido-schema.org source,attr,omitempty"`
+	Source xsdt.String `xml:"http://www.lido-schema.org source,attr,omitempty" json:"source,omitempty"`
 
-	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty" json:"type,omitempty"`
 }
 
 type DescriptiveNote struct {
-	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty" json:"type,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 
 	//	Definition: Identifier for an external resource describing the entity.
 	//	Notes: The referenced resource may be any kind of document, preferably web-accessible.
-	IDs []*Identifier `xml:"http://www.lido-schema.org descriptiveNoteID"`
+	IDs []*Identifier `xml:"http://www.lido-schema.org descriptiveNoteID" json:"descriptiveNoteID,omitempty"`
 
 	//	Definition: Usually a relatively brief essay-like text that describes the entity.
 	//	How to record: Repeat this element only for language variants.
-	Values []*Text `xml:"http://www.lido-schema.org descriptiveNoteValue"`
+	Values []*Text `xml:"http://www.lido-schema.org descriptiveNoteValue" json:"descriptiveNoteValue,omitempty"`
 
 	//	DeTefinition: The source for the descriptive note, generally a published source.
-	Sources []*Text `xml:"http://www.lido-schema.org sourceDescriptiveNote"`
+	Sources []*Text `xml:"http://www.lido-schema.org sourceDescriptiveNote" json:"sourceDescriptiveNote,omitempty"`
 }
 
 type EventActor struct {
@@ -982,7 +1017,7 @@ type EventActor struct {
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 type ActorInRoleSet struct {
@@ -997,19 +1032,19 @@ type ActorInRoleSet struct {
 	// acceptable. Include nationality and life dates. For unknown actors, use
 	// e.g.: "unknown," "unknown Chinese," "Chinese," or "unknown 15th century
 	// Chinese."Repeat this element only for language variants.
-	DisplayActorInRoles []*Text `xml:"http://www.lido-schema.org displayActorInRole"`
+	DisplayActorInRoles []*Text `xml:"http://www.lido-schema.org displayActorInRole" json:"displayActorInRole,omitempty"`
 
 	//	Definition: Describes an actor with role and (if necessary) attributions in a structured way, consisting of the sub-elements actor, its role, attribution and extent.
-	ActorInRole *ActorInRole `xml:"http://www.lido-schema.org actorInRole"`
+	ActorInRole *ActorInRole `xml:"http://www.lido-schema.org actorInRole" json:"actorInRole,omitempty"`
 }
 
 type ActorInRole struct {
 	// Contains structured identifying and indexing actor information.
-	Actor *Actor `xml:"http://www.lido-schema.org actor"`
+	Actor *Actor `xml:"http://www.lido-schema.org actor" json:"actor,omitempty"`
 
 	// Role of the Actor in the event. Preferably taken from a published
 	// controlled vocabulary.
-	RoleActors []*ConceptElement `xml:"http://www.lido-schema.org roleActor"`
+	RoleActors []*ConceptElement `xml:"http://www.lido-schema.org roleActor" json:"roleActor,omitempty"`
 
 	// A qualifier used when the attribution is uncertain, is in dispute, when
 	// there is more than one actor, when there is a former attribution, or when
@@ -1017,30 +1052,30 @@ type ActorInRole struct {
 	// to, studio of, workshop of, atelier of, office of, assistant of, associate
 	// of, pupil of, follower of, school of, circle of, style of, after copyist
 	// of, manner of...
-	AttributionQualifierActors []*Text `xml:"http://www.lido-schema.org attributionQualifierActor"`
+	AttributionQualifierActors []*Text `xml:"http://www.lido-schema.org attributionQualifierActor" json:"attributionQualifierActor,omitempty"`
 
 	// Extent of the actor's participation in the event, if there are several
 	// actors. Example values: design, execution, with additions by, figures,
 	// renovation by, predella, embroidery, cast by, printed by, ...
-	ExtentActors []*Text `xml:"http://www.lido-schema.org extentActor"`
+	ExtentActors []*Text `xml:"http://www.lido-schema.org extentActor" json:"extentActor,omitempty"`
 }
 
 // In some cases the actor will be encrypted such as events.
 type Actor struct {
-	XMLName xml.Name `xml:"http://www.lido-schema.org actor"`
+	XMLName xml.Name `xml:"http://www.lido-schema.org actor" json:"-"`
 	// A unique identifier for the actor. Preferably taken from a published
 	// authority file.
-	ActorIDs []*Identifier `xml:"http://www.lido-schema.org actorID"`
+	ActorIDs []*Identifier `xml:"http://www.lido-schema.org actorID" json:"actorID,omitempty"`
 
 	// A wrapper for name elements. if there exists more than one name for a
 	// single actor, repeat Name Actor Set. Indicates names, appellations, or
 	// other identifiers assigned to an individual, group of people, firm or other
 	// corporate body, or other entity.
-	NameActorSets []*Appellation `xml:"http://www.lido-schema.org nameActorSet"`
+	NameActorSets []*Appellation `xml:"http://www.lido-schema.org nameActorSet" json:"nameActorSet,omitempty"`
 
 	// National or cultural affiliation of the person or corporate body.
 	// Preferably taken from a published controlled vocabulary.
-	NationalityActors []*ConceptElement `xml:"http://www.lido-schema.org nationalityActor"`
+	NationalityActors []*ConceptElement `xml:"http://www.lido-schema.org nationalityActor" json:"nationalityActor,omitempty"`
 
 	// The lifespan of the person or the existence of the corporate body or group.
 	// For individuals, record birth date as earliest and death date as latest
@@ -1051,22 +1086,22 @@ type Actor struct {
 	// date may specify for indiviudals, if birth and death dates or if dates of
 	// activity are recorded. Data values for type attribute may include:
 	// birthDate, deathDate, estimatedDate.
-	VitalDatesActor *DateSpan `xml:"http://www.lido-schema.org vitalDatesActor"`
+	VitalDatesActor *DateSpan `xml:"http://www.lido-schema.org vitalDatesActor" json:"vitalDatesActor,omitempty"`
 
 	// The sex of the individual. Data values: male, female, unknown, not
 	// applicable.Repeat this element for language variants only.
 	// Notes: Not applicable for corporate bodies.
-	GenderActors []*Text `xml:"http://www.lido-schema.org genderActor"`
+	GenderActors []*Text `xml:"http://www.lido-schema.org genderActor" json:"genderActor,omitempty"`
 
 	// Indicates if the actor is an individual, a group of individuals, a family
 	// or a corporation (firm or other corporate body). Data values: person,
 	// group, family, corporation.
-	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty" json:"type,omitempty"`
 }
 
 type RelatedEvent struct {
 	// Display and index elements for the event related to the event being recorded.
-	RelatedEvent *EventElement `xml:"http://www.lido-schema.org relatedEvent"`
+	RelatedEvent *EventElement `xml:"http://www.lido-schema.org relatedEvent" json:"relatedEvent,omitempty"`
 
 	// A term describing the nature of the relationship between the described
 	// event and the related event. Example values: part of, influence of,
@@ -1076,11 +1111,11 @@ type RelatedEvent struct {
 	// Notes: For implementation of the data, note that relationships are
 	// conceptually reciprocal, but the Relationship Type is often different on
 	// either side of the relationship.
-	RelatedEventRelType *ConceptElement `xml:"http://www.lido-schema.org relatedEventRelType"`
+	RelatedEventRelType *ConceptElement `xml:"http://www.lido-schema.org relatedEventRelType" json:"relatedEventRelType,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 type EventMaterialsTech struct {
@@ -1088,27 +1123,27 @@ type EventMaterialsTech struct {
 	// materialsTech element. It is presented in a syntax suitable for display to
 	// the end-user and including any necessary indications of uncertainty,
 	// ambiguity, and nuance.Repeat this element only for language variants.
-	DisplayMaterialsTechs []*Text `xml:"http://www.lido-schema.org displayMaterialsTech"`
+	DisplayMaterialsTechs []*Text `xml:"http://www.lido-schema.org displayMaterialsTech" json:"displayMaterialsTech,omitempty"`
 
 	// Materials and techniques data used for indexing.
-	MaterialsTech *MaterialsTech `xml:"http://www.lido-schema.org materialsTech"`
+	MaterialsTech *MaterialsTech `xml:"http://www.lido-schema.org materialsTech" json:"materialsTech,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 type MaterialsTech struct {
-	XMLName xml.Name `xml:"http://www.lido-schema.org materialsTech"`
+	XMLName xml.Name `xml:"http://www.lido-schema.org materialsTech" json:"-"`
 	// A concept to index materials and/or technique. Preferably taken from a
 	// published controlled vocabulary.
-	TermMaterialsTechs []*ClassificationElement `xml:"http://www.lido-schema.org termMaterialsTech"`
+	TermMaterialsTechs []*ClassificationElement `xml:"http://www.lido-schema.org termMaterialsTech" json:"termMaterialsTech,omitempty"`
 
 	// An explanation of the part of the object / work to which the corresponding
 	// materials or technique are applicable; included when necessary for clarity.
-	ExtentMaterialsTechs []*Text `xml:"http://www.lido-schema.org extentMaterialsTech"`
+	ExtentMaterialsTechs []*Text `xml:"http://www.lido-schema.org extentMaterialsTech" json:"extentMaterialsTech,omitempty"`
 
 	// The source of the information about materials and technique, often used
 	//when citing a published source of watermarks.
-	SourceMaterialsTechs []*Text `xml:"http://www.lido-schema.org sourceMaterialsTech"`
+	SourceMaterialsTechs []*Text `xml:"http://www.lido-schema.org sourceMaterialsTech" json:"sourceMaterialsTech,omitempty"`
 }
@@ -5,51 +5,51 @@ import (
 )
 
 type MeasurementsWrap struct {
-	MeasurementsSets []*MeasurementsSet `xml:"http://www.lido-schema.org objectMeasurementsSet"`
+	MeasurementsSets []*MeasurementsSet `xml:"http://www.lido-schema.org objectMeasurementsSet" json:"objectMeasurementsSet,omitempty"`
 }
 
 type MeasurementsSet struct {
 	//  Definition: Display element for one object measurement, corresponding to the following objectMeasurement element.
 	//  How to record: Repeat this element only for language variants.
-	DisplayMeasurements []*Text `xml:"http://www.lido-schema.org displayObjectMeasurements"`
+	DisplayMeasurements []*Text `xml:"http://www.lido-schema.org displayObjectMeasurements" json:"displayObjectMeasurements,omitempty"`
 
 	// Structured measurement information about the dimensions, size, or scale of
 	// the object / work. it may also include the parts of a complex object /
 	// work, series, or collection.
-	Measurements *Measurements `xml:"http://www.lido-schema.org objectMeasurements"`
+	Measurements *Measurements `xml:"http://www.lido-schema.org objectMeasurements" json:"objectMeasurements,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 type Measurements struct {
 	//  Definition: The configuration of an object / work, including technical formats. Used as necessary.
 	//  How to record: Example values: Vignette, VHS, IMAX, and DOS
-	FormatMeasurements []*ExtentMeasurement `xml:"http://www.lido-schema.org formatMeasurements"`
+	FormatMeasurements []*ExtentMeasurement `xml:"http://www.lido-schema.org formatMeasurements" json:"formatMeasurements,omitempty"`
 
 	//  Definition: The shape of an object / work. Used for unusual shapes (e.g., an oval painting).
 	//  How to record: Example values: oval, round, square, rectangular, and irregular.
-	ShapeMeasurements []*ExtentMeasurement `xml:"http://www.lido-schema.org shapeMeasurements"`
+	ShapeMeasurements []*ExtentMeasurement `xml:"http://www.lido-schema.org shapeMeasurements" json:"shapeMeasurements,omitempty"`
 
 	//  Definition: An expression of the ratio between the size of the representation of something and that thing (e.g., the size of the drawn structure and the actual built work).
 	//  How to record: Example values for scale: numeric (e.g., 1 inch = 1 foot), full-size, life-size, half size,monumental. and others as recommended in CCO and CDWA. Combine this tag with Measurement Sets for numeric scales. For measurementsSet type for Scale, use "base" for the left side of the equation, and "target" for the right side of the equation).
 	//  Notes: Used for studies, record drawings, models, and other representations drawn or constructed to scale.
-	ScaleMeasurements []*ExtentMeasurement `xml:"http://www.lido-schema.org scaleMeasurements"`
+	ScaleMeasurements []*ExtentMeasurement `xml:"http://www.lido-schema.org scaleMeasurements" json:"scaleMeasurements,omitempty"`
 
 	// The dimensions or other measurements for one aspect of an object / work
 	// (e.g., width). May be combined with extent, qualifier, and other
 	// sub-elements as necessary.The subelements "measurementUnit",
 	// "measurementValue" and "measurementType" are mandatory.
-	MeasurementsSets []*AspectMeasurements `xml:"http://www.lido-schema.org measurementsSet"`
+	MeasurementsSets []*AspectMeasurements `xml:"http://www.lido-schema.org measurementsSet" json:"measurementsSet,omitempty"`
 
 	//  Definition: An explanation of the part of the object / work being measured included, when necessary, for clarity.
 	//  How to record: Example values: overall, components, sheet, plate mark, chain lines, pattern repeat, lid, base, laid lines, folios, leaves, columns per page, lines per page, tessera, footprint, panel, interior, mat, window of mat, secondary support, frame, and mount
-	ExtentMeasurements []*ExtentMeasurement `xml:"http://www.lido-schema.org extentMeasurements"`
+	ExtentMeasurements []*ExtentMeasurement `xml:"http://www.lido-schema.org extentMeasurements" json:"extentMeasurements,omitempty"`
 
 	//  Definition: A word or phrase that elaborates on the nature of the measurements of the object / work when necessary, e.g. when the measurements are approximate.
 	//  How to record: Example values: approximate, sight, maximum, larges, smallest, average, variable, assembled, before restoration, before restoration, at corners, rounded, framed, and with base.
-	QualifierMeasurements []*ExtentMeasurement `xml:"http://www.lido-schema.org qualifierMeasurements"`
+	QualifierMeasurements []*ExtentMeasurement `xml:"http://www.lido-schema.org qualifierMeasurements" json:"qualifierMeasurements,omitempty"`
 }
 
 type ExtentMeasurement struct {
@@ -57,7 +57,7 @@ type ExtentMeasurement struct {
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
 
 //  Definition: Structured measurement information about the dimensions, size, or scale of the object / work.
@@ -71,17 +71,17 @@ type ExtentMeasurement struct {
 type AspectMeasurements struct {
 	//  Definition: Indicates what kind of measurement is taken.
 	//  How to record: Data values for type: height, width, depth, length, diameter, circumference, stories, count, area, volume, running time, size.Repeat this element only for language variants.
-	Types []*Text `xml:"http://www.lido-schema.org measurementType"`
+	Types []*Text `xml:"http://www.lido-schema.org measurementType" json:"measurementType,omitempty"`
 
 	//  Definition: The unit of the measurement.
 	//  How to record: E.g. cm, mm, m, g, kg, kb, Mb or Gb.Repeat this element only for language variants.
-	Units []*Text `xml:"http://www.lido-schema.org measurementUnit"`
+	Units []*Text `xml:"http://www.lido-schema.org measurementUnit" json:"measurementUnit,omitempty"`
 
 	//  Definition: The value of the measurement.
 	//  How to record: Whole numbers or decimal fractions.
-	Value Text `xml:"http://www.lido-schema.org measurementValue"`
+	Value Text `xml:"http://www.lido-schema.org measurementValue" json:"measurementValue,omitempty"`
 
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 }
@@ -0,0 +1,327 @@
+package lido
+
+import (
+	"regexp"
+	"strings"
+)
+
+// The accessors below read common data out of a record without walking its
+// wrappers by hand. They tolerate missing elements and nil entries, and
+// return "" or nil where there is nothing to read.
+//
+// Values are chosen by language first and preference second. lang is a
+// priority list as parsed by ParseLangPriority, such as "de-CH, de, en", and
+// languages rank as described for LangPriority. Among values of the same
+// rank, one with pref="preferred", or no pref at all, beats an alternate one,
+// and ties go to the value that comes first. In the accessors on Lido,
+// values without an xml:lang of their own inherit one as resolved by
+// LangResolver.
+//
+// Language variants of descriptiveMetadata repeat the same data, so the
+// accessors returning lists read the first descriptiveMetadata element that
+// has any.
+
+// Tracks the node whose value best matches a priority list so far.
+type choice struct {
+	priority  LangPriority
+	node      interface{}
+	rank      int
+	alternate bool
+}
+
+// Considers a node with its value, its effective language and its pref
+// attribute.
+func (c *choice) consider(node interface{}, value string, lang string, pref string) {
+	if strings.TrimSpace(value) == "" {
+		return
+	}
+	rank := c.priority.rank(lang)
+	alternate := pref == Alternate
+	if c.node == nil || rank < c.rank || (rank == c.rank && c.alternate && !alternate) {
+		c.node, c.rank, c.alternate = node, rank, alternate
+	}
+}
+
+// Reports whether s is written as a URI: an http, https or urn URI.
+func IsURI(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://") || strings.HasPrefix(s, "urn:")
+}
+
+// Returns the identifier if it is a URI, such as a conceptID from a
+// vocabulary, or "" if it is not.
+func (id *Identifier) URI() string {
+	if id == nil {
+		return ""
+	}
+	if v := strings.TrimSpace(string(id.Value)); IsURI(v) {
+		return v
+	}
+	return ""
+}
+
+// Returns the first of the identifiers that is a URI, or "".
+func URIOf(ids []*Identifier) string {
+	for _, id := range ids {
+		if uri := id.URI(); uri != "" {
+			return uri
+		}
+	}
+	return ""
+}
+
+// Returns the value of the appellation that best matches lang.
+func (apl *Appellation) PreferredValue(lang string) string {
+	return appellationValue(NewLangResolver(nil).Value(ParseLangPriority(lang), apl))
+}
+
+// Returns the term of the concept that best matches lang, leaving out added
+// search terms.
+func (c *Concept) PreferredTerm(lang string) string {
+	if t := NewLangResolver(nil).Term(ParseLangPriority(lang), c); t != nil {
+		return strings.TrimSpace(string(t.Value))
+	}
+	return ""
+}
+
+// Returns the name of the actor that best matches lang.
+func (a *Actor) PreferredName(lang string) string {
+	return appellationValue(NewLangResolver(nil).Value(ParseLangPriority(lang), a.NameActorSets...))
+}
+
+// Returns the text that best matches lang.
+func PreferredText(texts []*Text, lang string) string {
+	if t := NewLangResolver(nil).Text(ParseLangPriority(lang), texts...); t != nil {
+		return strings.TrimSpace(string(t.Value))
+	}
+	return ""
+}
+
+func appellationValue(v *AppellationValue) string {
+	if v == nil {
+		return ""
+	}
+	return strings.TrimSpace(string(v.Value))
+}
+
+// The LIDO terminology URI of the production event type.
+const ProductionEventType = "http://terminology.lido-schema.org/lido00007"
+
+var crmClassPattern = regexp.MustCompile(`^(E\d+)(_|$)`)
+
+// Returns the code of a CIDOC CRM class, e.g. "E12", if the identifier is the
+// URI of one, as written by NewCRMConcept, or "".
+func (id *Identifier) CRMClass() string {
+	uri := id.URI()
+	if !strings.Contains(uri, "cidoc-crm.org/") {
+		return ""
+	}
+	m := crmClassPattern.FindStringSubmatch(uri[strings.LastIndex(uri, "/")+1:])
+	if m == nil {
+		return ""
+	}
+	return m[1]
+}
+
+// Reports whether an event is a production or creation event, whose actors
+// are the creators of the object / work. The event type has to be the LIDO
+// term for production, ProductionEventType, the CRM class E12 Production, as
+// written by AppendCRMType, or have one of the terms production, creation or
+// herstellung, in any case. Other vocabularies, such as AAT, and terms in
+// other languages are not recognized.
+func (e *Event) IsCreation() bool {
+	for _, t := range e.EventTypes {
+		if t == nil {
+			continue
+		}
+		for _, id := range t.ConceptIDs {
+			if id != nil && (strings.TrimSpace(string(id.Value)) == ProductionEventType || id.CRMClass() == "E12") {
+				return true
+			}
+		}
+		for _, term := range t.Terms {
+			if term == nil {
+				continue
+			}
+			switch strings.ToLower(strings.TrimSpace(string(term.Value))) {
+			case "production", "creation", "herstellung":
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Returns the title that best matches lang, across the titleSets of all
+// descriptiveMetadata elements.
+func (l *Lido) PreferredTitle(lang string) string {
+	var titles []*Appellation
+	for _, dm := range l.DescriptiveMetadatas {
+		if dm == nil {
+			continue
+		}
+		for _, title := range dm.ObjectID.TitleWrap.Titles {
+			if title != nil {
+				titles = append(titles, &title.Appellation)
+			}
+		}
+	}
+	return appellationValue(NewLangResolver(l).Value(ParseLangPriority(lang), titles...))
+}
+
+// Calls fn with each descriptiveMetadata element until fn reports that it
+// found something.
+func (l *Lido) firstDesc(fn func(dm *DescriptiveMetadata) bool) {
+	for _, dm := range l.DescriptiveMetadatas {
+		if dm != nil && fn(dm) {
+			return
+		}
+	}
+}
+
+func (dm *DescriptiveMetadata) events() (events []*Event) {
+	if dm.EventWrap == nil {
+		return
+	}
+	for _, set := range dm.EventWrap.Events {
+		if set != nil && set.Event != nil {
+			events = append(events, set.Event)
+		}
+	}
+	return
+}
+
+// Returns the actors of the production and creation events, as reported by
+// Event.IsCreation.
+func (l *Lido) Creators() (actors []*Actor) {
+	l.firstDesc(func(dm *DescriptiveMetadata) bool {
+		for _, event := range dm.events() {
+			if !event.IsCreation() {
+				continue
+			}
+			for _, actor := range event.EventActors {
+				if actor != nil && actor.Actor != nil {
+					actors = append(actors, actor.Actor)
+				}
+			}
+		}
+		return len(actors) > 0
+	})
+	return
+}
+
+// Returns the date of the first production or creation event that has one,
+// or nil.
+func (l *Lido) ProductionDate() (date *DateSet) {
+	l.firstDesc(func(dm *DescriptiveMetadata) bool {
+		for _, event := range dm.events() {
+			if event.IsCreation() && event.Date != nil {
+				date = event.Date
+				return true
+			}
+		}
+		return false
+	})
+	return
+}
+
+// Returns the repositories of the object / work.
+func (l *Lido) Repositories() (repositories []*Repository) {
+	l.firstDesc(func(dm *DescriptiveMetadata) bool {
+		if dm.ObjectID.RepositoryWrap == nil {
+			return false
+		}
+		for _, r := range dm.ObjectID.RepositoryWrap.Repositories {
+			if r != nil {
+				repositories = append(repositories, r)
+			}
+		}
+		return len(repositories) > 0
+	})
+	return
+}
+
+// Returns the inventory numbers, or other work identifiers, given by the
+// repositories of the object / work.
+func (l *Lido) WorkIDs() (ids []string) {
+	for _, r := range l.Repositories() {
+		for _, id := range r.WorkIDs {
+			if id != nil && strings.TrimSpace(string(id.XsdtString)) != "" {
+				ids = append(ids, strings.TrimSpace(string(id.XsdtString)))
+			}
+		}
+	}
+	return
+}
+
+// Returns the materials and techniques of all events. Their Type tells
+// materials from techniques where the record makes the distinction.
+func (l *Lido) Materials() (materials []*ClassificationElement) {
+	l.firstDesc(func(dm *DescriptiveMetadata) bool {
+		for _, event := range dm.events() {
+			for _, mt := range event.EventMaterialsTechs {
+				if mt == nil || mt.MaterialsTech == nil {
+					continue
+				}
+				for _, term := range mt.MaterialsTech.TermMaterialsTechs {
+					if term != nil {
+						materials = append(materials, term)
+					}
+				}
+			}
+		}
+		return len(materials) > 0
+	})
+	return
+}
+
+// Returns the structured measurements of the object / work, such as its
+// height and width.
+func (l *Lido) Measurements() (measurements []*AspectMeasurements) {
+	l.firstDesc(func(dm *DescriptiveMetadata) bool {
+		if dm.ObjectID.MeasurementsWrap == nil {
+			return false
+		}
+		for _, set := range dm.ObjectID.MeasurementsWrap.MeasurementsSets {
+			if set == nil || set.Measurements == nil {
+				continue
+			}
+			for _, m := range set.Measurements.MeasurementsSets {
+				if m != nil {
+					measurements = append(measurements, m)
+				}
+			}
+		}
+		return len(measurements) > 0
+	})
+	return
+}
+
+// Returns the resource representations that link to an image: those whose
+// MIME type is image/*, and those without a MIME type in resource sets whose
+// type has the term image. All returned representations have a LinkResource.
+func (l *Lido) Images() (images []*ResourceRep) {
+	for _, am := range l.AdministrativeMetadatas {
+		if am == nil || am.ResourceWrap == nil {
+			continue
+		}
+		for _, set := range am.ResourceWrap.ResourceSets {
+			if set == nil {
+				continue
+			}
+			typedImage := set.ResourceType != nil && strings.Contains(strings.ToLower(set.ResourceType.PreferredTerm("")), "image")
+			for _, rep := range set.ResourceRepresentations {
+				if rep == nil || rep.LinkResource == nil || strings.TrimSpace(string(rep.LinkResource.XsdtString)) == "" {
+					continue
+				}
+				format := strings.ToLower(strings.TrimSpace(string(rep.LinkResource.FormatResource)))
+				if strings.HasPrefix(format, "image/") || (format == "" && typedImage) {
+					images = append(images, rep)
+				}
+			}
+		}
+		if len(images) > 0 {
+			break
+		}
+	}
+	return
+}
@@ -0,0 +1,88 @@
+package lido
+
+import (
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	l := &Lido{}
+	if l.PreferredTitle("en") != "" || l.Creators() != nil || l.ProductionDate() != nil || l.WorkIDs() != nil || l.Images() != nil {
+		t.Errorf("empty record has data")
+	}
+
+	en := l.CreateDesc("en")
+	en.AddTitle("Spring", "", false, "")
+	en.AddTitle("Primavera", "it", true, RepositoryTitle)
+	en.ObjectID.TitleWrap.Titles[1].Append("La Primavera", "it", false)
+	en.AddRepository("Galleria degli Uffizi", "Inv. 8360", nil)
+	en.AddMeasurementsSet("207 x 319 cm", "")
+	en.AddMeasurement("height", "cm", "207")
+	en.AddMeasurement("width", "cm", "319")
+	acquisition := en.AddEvent(NewURIConcept("", "Acquisition", ""))
+	acquisition.AddActor(NewActor("Medici", ""), nil)
+	acquisition.SetDisplayDate("1815", "")
+	production := en.AddEvent(NewURIConcept(ProductionEventType, "Herstellung", "de"))
+	botticelli := NewActor("Botticelli, Sandro", "")
+	botticelli.NameActorSets[0].Append("Alessandro Filipepi", "it", false)
+	production.AddActor(botticelli, nil)
+	production.SetDisplayDate("ca. 1480", "")
+	production.AddMaterialsTech(NewURIConcept("", "tempera", ""), "material")
+
+	de := l.CreateDesc("de")
+	de.AddTitle("Der Frühling", "", true, "")
+	de.AddRepository("Uffizien", "Inv. 8360", nil)
+
+	tests := map[string]string{"en": "Spring", "it": "Primavera", "de": "Der Frühling", "fr": "Primavera", "": "Primavera"}
+	for lang, want := range tests {
+		if got := l.PreferredTitle(lang); got != want {
+			t.Errorf("PreferredTitle(%q) = %q, want %q", lang, got, want)
+		}
+	}
+	if creators := l.Creators(); len(creators) != 1 || creators[0].PreferredName("en") != "Botticelli, Sandro" ||
+		creators[0].PreferredName("it") != "Alessandro Filipepi" {
+		t.Errorf("unexpected creators: %+v", creators)
+	}
+	if date := l.ProductionDate(); date == nil || PreferredText(date.DisplayDates, "") != "ca. 1480" {
+		t.Errorf("unexpected production date: %+v", date)
+	}
+	if ids := l.WorkIDs(); len(ids) != 1 || ids[0] != "Inv. 8360" {
+		t.Errorf("unexpected work IDs: %v", ids)
+	}
+	if r := l.Repositories(); len(r) != 1 || r[0].RepositoryName.LegalBodyNames[0].PreferredValue("") != "Galleria degli Uffizi" {
+		t.Errorf("unexpected repositories: %+v", r)
+	}
+	if m := l.Materials(); len(m) != 1 || m[0].PreferredTerm("en") != "tempera" || m[0].Type != "material" {
+		t.Errorf("unexpected materials: %+v", m)
+	}
+	if m := l.Measurements(); len(m) != 2 || m[1].Value.Value != "319" {
+		t.Errorf("unexpected measurements: %+v", m)
+	}
+
+	am := l.CreateAdmin("en")
+	am.AddResource("https://example.org/primavera.jpg", "image/jpeg").AddRepresentation("https://example.org/primavera.pdf", "application/pdf", "")
+	typed := am.AddResource("https://example.org/primavera.tif", "")
+	am.AddResource("https://example.org/untyped", "")
+	typed.SetType(NewURIConcept("", "Image", ""))
+	if images := l.Images(); len(images) != 2 || images[1].LinkResource.XsdtString != "https://example.org/primavera.tif" {
+		t.Errorf("unexpected images: %+v", images)
+	}
+}
+
+func TestIsCreation(t *testing.T) {
+	for _, test := range []struct {
+		eventType *Concept
+		want      bool
+	}{
+		{NewURIConcept(ProductionEventType, "Produktion", "de"), true},
+		{NewURIConcept("http://www.cidoc-crm.org/cidoc-crm/E12_Production", "Production", "en"), true},
+		{NewURIConcept("http://example.org/event", "Creation", "en"), true},
+		{NewURIConcept("http://www.cidoc-crm.org/cidoc-crm/E8_Acquisition", "Acquisition", "en"), false},
+		{NewURIConcept("http://vocab.getty.edu/aat/300054713", "Herstellung", "de"), true},
+		{NewURIConcept("http://vocab.getty.edu/aat/300054713", "producción", "es"), false},
+	} {
+		e := &Event{EventTypes: []*Concept{test.eventType}}
+		if got := e.IsCreation(); got != test.want {
+			t.Errorf("IsCreation(%s) = %v, want %v", test.eventType.PreferredTerm(""), got, test.want)
+		}
+	}
+}
@@ -5,24 +5,24 @@ import (
 )
 
 type RepositoryWrap struct {
-	Repositories []*Repository `xml:"http://www.lido-schema.org repositorySet"`
+	Repositories []*Repository `xml:"http://www.lido-schema.org repositorySet" json:"repositorySet,omitempty"`
 }
 
 type Repository struct {
 	// Assigns a priority order for online presentation of the element. Has to be
 	// a positive integer, with descending priority from 1 to x.
-	SortOrder xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty"`
+	SortOrder *xsdt.Integer `xml:"http://www.lido-schema.org sortorder,attr,omitempty" json:"sortorder,omitempty"`
 
 	//  Definition: Qualifies the repository as a former or the current repository.
 	//  How to record: Data values: current, former
-	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.lido-schema.org type,attr,omitempty" json:"type,omitempty"`
 
 	//  Definition: Unambiguous identification, designation and weblink of the institution of custody.
-	RepositoryName *LegalBodyRef `xml:"http://www.lido-schema.org repositoryName"`
+	RepositoryName *LegalBodyRef `xml:"http://www.lido-schema.org repositoryName" json:"repositoryName,omitempty"`
 
 	//  Definition: An unambiguous numeric or alphanumeric identification number, assigned to the object by the institution of custody.
-	WorkIDs []*WorkID `xml:"http://www.lido-schema.org workID"`
+	WorkIDs []*WorkID `xml:"http://www.lido-schema.org workID" json:"workID,omitempty"`
 
 	//  Definition: Location of the object, especially relevant for architecture and archaeological sites.
-	RepositoryLocation *Place `xml:"http://www.lido-schema.org repositoryLocation"`
+	RepositoryLocation *Place `xml:"http://www.lido-schema.org repositoryLocation" json:"repositoryLocation,omitempty"`
 }
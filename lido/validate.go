@@ -0,0 +1,661 @@
+package lido
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/verisart/xsd/xsdt"
+)
+
+// A violation of the LIDO 1.0 schema found by Lido.Validate.
+type ValidationError struct {
+	// Location of the offending element or attribute relative to the lido
+	// element, e.g. "descriptiveMetadata[0]/objectIdentificationWrap/titleWrap"
+	// or "lidoRecID[0]/@type". Empty for the lido element itself.
+	Path string
+
+	// What is wrong, e.g. "missing titleSet (at least 1 required)".
+	Msg string
+
+	// The underlying error, e.g. the xsdt.ValidationError for a malformed
+	// language tag, or nil.
+	Err error
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return "lido: " + e.Msg
+	}
+	return "lido: " + e.Path + ": " + e.Msg
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// The errors returned by Lido.Validate, in document order.
+type ValidationErrors []*ValidationError
+
+func (errs ValidationErrors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Checks the record against the constraints of the LIDO 1.0 schema that the
+// Go types cannot express: the cardinality of elements (lidoRecID,
+// descriptiveMetadata, objectWorkType, titleSet, recordID and so on),
+// required attributes (xml:lang on descriptive and administrative metadata,
+// type on lidoRecID, recordID and conceptID), the values of the pref
+// (preferred, alternate) and addedSearchTerm (yes, no) attributes, and the
+// syntax of every xml:lang tag. It returns nil if the record is valid, and
+// otherwise a ValidationErrors, which errors.As recovers; a nil record gives
+// a single error.
+func (l *Lido) Validate() error {
+	if l == nil {
+		return ValidationErrors{{Msg: "missing lido record"}}
+	}
+	v := &validator{}
+
+	v.require("", "lidoRecID", len(l.LidoRecIDs))
+	for i, id := range l.LidoRecIDs {
+		v.identifier(IndexPath("", "lidoRecID", i), id, true)
+	}
+	for i, id := range l.ObjectPublishedIDs {
+		v.identifier(IndexPath("", "objectPublishedID", i), id, false)
+	}
+	v.concept("category", l.Category)
+
+	v.require("", "descriptiveMetadata", len(l.DescriptiveMetadatas))
+	for i, dm := range l.DescriptiveMetadatas {
+		v.descriptiveMetadata(IndexPath("", "descriptiveMetadata", i), dm)
+	}
+	v.require("", "administrativeMetadata", len(l.AdministrativeMetadatas))
+	for i, am := range l.AdministrativeMetadatas {
+		v.administrativeMetadata(IndexPath("", "administrativeMetadata", i), am)
+	}
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+type validator struct {
+	errs ValidationErrors
+}
+
+// Returns the element path of the child element name of path, e.g.
+// "descriptiveMetadata[0]/objectIdentificationWrap", as used in
+// ValidationError.
+func JoinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "/" + name
+}
+
+// Like JoinPath, for the i-th of the repeated child elements name, e.g.
+// "descriptiveMetadata[0]".
+func IndexPath(path, name string, i int) string {
+	return JoinPath(path, name+"["+strconv.Itoa(i)+"]")
+}
+
+func (v *validator) errorf(path string, err error, msg string) {
+	v.errs = append(v.errs, &ValidationError{Path: path, Msg: msg, Err: err})
+}
+
+// Reports a missing child element if the parent at path holds none.
+func (v *validator) require(path, name string, n int) {
+	if n == 0 {
+		v.errorf(path, nil, "missing "+name+" (at least 1 required)")
+	}
+}
+
+func (v *validator) lang(path string, lang xsdt.Language, required bool) {
+	if lang == "" {
+		if required {
+			v.errorf(JoinPath(path, "@xml:lang"), nil, "missing required attribute")
+		}
+		return
+	}
+	if err := lang.Validate(); err != nil {
+		v.errorf(JoinPath(path, "@xml:lang"), err, "invalid language tag "+strconv.Quote(string(lang)))
+	}
+}
+
+func (v *validator) pref(path string, pref xsdt.String) {
+	if pref != "" && pref != Preferred && pref != Alternate {
+		v.errorf(JoinPath(path, "@pref"), nil, "invalid value "+strconv.Quote(string(pref))+
+			" (must be "+Preferred+" or "+Alternate+")")
+	}
+}
+
+func (v *validator) texts(path, name string, texts []*Text) {
+	for i, text := range texts {
+		if text != nil {
+			v.lang(IndexPath(path, name, i), text.Lang, false)
+		}
+	}
+}
+
+func (v *validator) notes(path, name string, notes []*Note) {
+	for i, note := range notes {
+		if note != nil {
+			v.lang(IndexPath(path, name, i), note.Lang, false)
+		}
+	}
+}
+
+func (v *validator) identifier(path string, id *Identifier, typeRequired bool) {
+	if id == nil {
+		return
+	}
+	if strings.TrimSpace(string(id.Value)) == "" {
+		v.errorf(path, nil, "empty identifier")
+	}
+	if typeRequired && id.Type == "" {
+		v.errorf(JoinPath(path, "@type"), nil, "missing required attribute")
+	}
+	v.pref(path, id.Pref)
+}
+
+func (v *validator) identifiers(path, name string, ids []*Identifier, typeRequired bool) {
+	for i, id := range ids {
+		v.identifier(IndexPath(path, name, i), id, typeRequired)
+	}
+}
+
+func (v *validator) concept(path string, c *Concept) {
+	if c == nil {
+		return
+	}
+	if len(c.ConceptIDs) == 0 && len(c.Terms) == 0 {
+		v.errorf(path, nil, "missing conceptID or term (at least 1 required)")
+	}
+	v.identifiers(path, "conceptID", c.ConceptIDs, true)
+	for i, term := range c.Terms {
+		if term == nil {
+			continue
+		}
+		p := IndexPath(path, "term", i)
+		v.lang(p, term.Lang, false)
+		v.pref(p, term.Pref)
+		if term.AddedSearchTerm != "" && term.AddedSearchTerm != "yes" && term.AddedSearchTerm != "no" {
+			v.errorf(JoinPath(p, "@addedSearchTerm"), nil, "invalid value "+
+				strconv.Quote(string(term.AddedSearchTerm))+" (must be yes or no)")
+		}
+	}
+}
+
+func (v *validator) concepts(path, name string, concepts []*Concept) {
+	for i, c := range concepts {
+		v.concept(IndexPath(path, name, i), c)
+	}
+}
+
+func (v *validator) conceptElements(path, name string, concepts []*ConceptElement) {
+	for i, c := range concepts {
+		if c != nil {
+			v.concept(IndexPath(path, name, i), &c.Concept)
+		}
+	}
+}
+
+func (v *validator) classifications(path, name string, classifications []*ClassificationElement) {
+	for i, c := range classifications {
+		if c != nil {
+			v.concept(IndexPath(path, name, i), &c.Concept)
+		}
+	}
+}
+
+func (v *validator) appellation(path string, a *Appellation) {
+	if a == nil {
+		return
+	}
+	v.require(path, "appellationValue", len(a.Values))
+	for i, value := range a.Values {
+		if value == nil {
+			continue
+		}
+		p := IndexPath(path, "appellationValue", i)
+		v.lang(p, value.Lang, false)
+		v.pref(p, value.Pref)
+	}
+	v.texts(path, "sourceAppellation", a.Sources)
+}
+
+func (v *validator) appellations(path, name string, appellations []*Appellation) {
+	for i, a := range appellations {
+		v.appellation(IndexPath(path, name, i), a)
+	}
+}
+
+func (v *validator) webResource(path string, r *WebResource) {
+	if r == nil {
+		return
+	}
+	v.lang(path, r.Lang, false)
+	v.pref(path, r.Pref)
+}
+
+func (v *validator) webResources(path, name string, resources []*WebResource) {
+	for i, r := range resources {
+		v.webResource(IndexPath(path, name, i), r)
+	}
+}
+
+func (v *validator) legalBody(path string, b *LegalBodyRef) {
+	if b == nil {
+		return
+	}
+	if len(b.LegalBodyIDs) == 0 && len(b.LegalBodyNames) == 0 && len(b.LegalBodyWeblinks) == 0 {
+		v.errorf(path, nil, "missing legalBodyID, legalBodyName or legalBodyWeblink (at least 1 required)")
+	}
+	v.identifiers(path, "legalBodyID", b.LegalBodyIDs, false)
+	v.appellations(path, "legalBodyName", b.LegalBodyNames)
+	v.webResources(path, "legalBodyWeblink", b.LegalBodyWeblinks)
+}
+
+func (v *validator) legalBodies(path, name string, bodies []*LegalBodyRef) {
+	for i, b := range bodies {
+		v.legalBody(IndexPath(path, name, i), b)
+	}
+}
+
+func (v *validator) descriptiveNotes(path, name string, notes []*DescriptiveNote) {
+	for i, note := range notes {
+		if note == nil {
+			continue
+		}
+		p := IndexPath(path, name, i)
+		v.identifiers(p, "descriptiveNoteID", note.IDs, false)
+		v.texts(p, "descriptiveNoteValue", note.Values)
+		v.texts(p, "sourceDescriptiveNote", note.Sources)
+	}
+}
+
+func (v *validator) dateSpan(path string, span *DateSpan) {
+	if span == nil {
+		return
+	}
+	if span.EarliestDate == nil && span.LatestDate == nil {
+		v.errorf(path, nil, "missing earliestDate or latestDate (at least 1 required)")
+	}
+}
+
+func (v *validator) dateSet(path string, set *DateSet) {
+	if set == nil {
+		return
+	}
+	v.texts(path, "displayDate", set.DisplayDates)
+	v.dateSpan(JoinPath(path, "date"), set.Date)
+}
+
+func (v *validator) place(path string, place *Place) {
+	if place == nil {
+		return
+	}
+	for i, part := range place.PartOfPlaces {
+		v.place(IndexPath(path, "partOfPlace", i), part)
+	}
+	for i, c := range place.PlaceClassifications {
+		if c != nil {
+			v.concept(IndexPath(path, "placeClassification", i), &c.Concept)
+		}
+	}
+	v.identifiers(path, "placeID", place.PlaceIDs, false)
+	v.appellations(path, "namePlaceSet", place.NamePlaceSets)
+	for i, g := range place.GMLs {
+		if g != nil {
+			v.lang(IndexPath(path, "gml", i), g.Lang, false)
+		}
+	}
+}
+
+func (v *validator) placeSet(path string, set *PlaceSet) {
+	if set == nil {
+		return
+	}
+	v.texts(path, "displayPlace", set.DisplayPlaces)
+	v.place(JoinPath(path, "place"), set.Place)
+}
+
+func (v *validator) actor(path string, actor *Actor) {
+	if actor == nil {
+		return
+	}
+	v.identifiers(path, "actorID", actor.ActorIDs, false)
+	v.require(path, "nameActorSet", len(actor.NameActorSets))
+	v.appellations(path, "nameActorSet", actor.NameActorSets)
+	v.conceptElements(path, "nationalityActor", actor.NationalityActors)
+	v.dateSpan(JoinPath(path, "vitalDatesActor"), actor.VitalDatesActor)
+	v.texts(path, "genderActor", actor.GenderActors)
+}
+
+func (v *validator) actorInRole(path string, role *ActorInRole) {
+	if role == nil {
+		return
+	}
+	if role.Actor == nil {
+		v.errorf(path, nil, "missing actor (exactly 1 required)")
+	}
+	v.actor(JoinPath(path, "actor"), role.Actor)
+	v.conceptElements(path, "roleActor", role.RoleActors)
+	v.texts(path, "attributionQualifierActor", role.AttributionQualifierActors)
+	v.texts(path, "extentActor", role.ExtentActors)
+}
+
+func (v *validator) object(path string, set *ObjectSet) {
+	if set == nil {
+		return
+	}
+	v.texts(path, "displayObject", set.DisplayObjects)
+	if set.Object != nil {
+		p := JoinPath(path, "object")
+		v.webResources(p, "objectWebResource", set.Object.ObjectWebResources)
+		v.identifiers(p, "objectID", set.Object.ObjectIDs, false)
+		v.notes(p, "objectNote", set.Object.ObjectNotes)
+	}
+}
+
+func (v *validator) measurements(path string, wrap *MeasurementsWrap) {
+	if wrap == nil {
+		return
+	}
+	for i, set := range wrap.MeasurementsSets {
+		if set == nil {
+			continue
+		}
+		p := IndexPath(path, "objectMeasurementsSet", i)
+		v.texts(p, "displayObjectMeasurements", set.DisplayMeasurements)
+		if set.Measurements == nil {
+			continue
+		}
+		p = JoinPath(p, "objectMeasurements")
+		for j, aspect := range set.Measurements.MeasurementsSets {
+			if aspect == nil {
+				continue
+			}
+			q := IndexPath(p, "measurementsSet", j)
+			v.require(q, "measurementType", len(aspect.Types))
+			v.texts(q, "measurementType", aspect.Types)
+			v.require(q, "measurementUnit", len(aspect.Units))
+			v.texts(q, "measurementUnit", aspect.Units)
+			if strings.TrimSpace(string(aspect.Value.Value)) == "" {
+				v.errorf(JoinPath(q, "measurementValue"), nil, "empty measurement value")
+			}
+			v.lang(JoinPath(q, "measurementValue"), aspect.Value.Lang, false)
+		}
+	}
+}
+
+func (v *validator) event(path string, event *Event) {
+	v.identifiers(path, "eventID", event.EventIDs, false)
+	if len(event.EventTypes) != 1 {
+		v.errorf(path, nil, "found "+strconv.Itoa(len(event.EventTypes))+" eventType elements (exactly 1 required)")
+	}
+	v.concepts(path, "eventType", event.EventTypes)
+	v.dateSet(JoinPath(path, "eventDate"), event.Date)
+	for i, place := range event.EventPlaces {
+		if place != nil {
+			v.placeSet(IndexPath(path, "eventPlace", i), &place.PlaceSet)
+		}
+	}
+	v.conceptElements(path, "eventMethod", event.EventMethods)
+	for i, thing := range event.ThingPresents {
+		if thing != nil {
+			v.object(IndexPath(path, "thingPresent", i), &thing.ObjectSet)
+		}
+	}
+	v.descriptiveNotes(path, "eventDescriptionSet", event.EventDescriptionSets)
+	v.appellations(path, "eventName", event.EventNames)
+	for i, actor := range event.EventActors {
+		if actor == nil {
+			continue
+		}
+		p := IndexPath(path, "eventActor", i)
+		v.actorInRole(JoinPath(p, "actorInRole"), &actor.ActorInRole)
+	}
+	for i, related := range event.RelatedEvents {
+		if related == nil {
+			continue
+		}
+		p := IndexPath(path, "relatedEventSet", i)
+		if related.RelatedEvent == nil {
+			v.errorf(p, nil, "missing relatedEvent (exactly 1 required)")
+		} else {
+			v.eventElement(JoinPath(p, "relatedEvent"), related.RelatedEvent)
+		}
+		if related.RelatedEventRelType != nil {
+			v.concept(JoinPath(p, "relatedEventRelType"), &related.RelatedEventRelType.Concept)
+		}
+	}
+	v.concepts(path, "roleInEvent", event.RoleInEvents)
+	v.conceptElements(path, "culture", event.Cultures)
+	v.classifications(path, "periodName", event.PeriodNames)
+	for i, mt := range event.EventMaterialsTechs {
+		if mt == nil {
+			continue
+		}
+		p := IndexPath(path, "eventMaterialsTech", i)
+		v.texts(p, "displayMaterialsTech", mt.DisplayMaterialsTechs)
+		if mt.MaterialsTech != nil {
+			q := JoinPath(p, "materialsTech")
+			v.classifications(q, "termMaterialsTech", mt.MaterialsTech.TermMaterialsTechs)
+			v.texts(q, "extentMaterialsTech", mt.MaterialsTech.ExtentMaterialsTechs)
+			v.texts(q, "sourceMaterialsTech", mt.MaterialsTech.SourceMaterialsTechs)
+		}
+	}
+	v.measurements(JoinPath(path, "objectMeasurementsWrap"), event.MeasurementsWrap)
+}
+
+func (v *validator) eventElement(path string, e *EventElement) {
+	v.texts(path, "displayEvent", e.DisplayEvents)
+	if e.Event != nil {
+		v.event(JoinPath(path, "event"), e.Event)
+	}
+}
+
+func (v *validator) subject(path string, s *Subject) {
+	for i, place := range s.SubjectPlaces {
+		v.placeSet(IndexPath(path, "subjectPlace", i), place)
+	}
+	for i, thing := range s.SubjectObjects {
+		if thing != nil {
+			v.object(IndexPath(path, "subjectObject", i), &thing.ObjectSet)
+		}
+	}
+	v.texts(path, "extentSubject", s.ExtentSubjects)
+	v.conceptElements(path, "subjectConcept", s.SubjectConcepts)
+	for i, actor := range s.SubjectActors {
+		if actor == nil {
+			continue
+		}
+		p := IndexPath(path, "subjectActor", i)
+		v.texts(p, "displayActor", actor.DisplayActors)
+		v.actor(JoinPath(p, "actor"), actor.Actor)
+	}
+	for i, date := range s.SubjectDates {
+		v.dateSpan(IndexPath(path, "subjectDate", i), date)
+	}
+	for i, event := range s.SubjectEvents {
+		if event != nil {
+			v.eventElement(IndexPath(path, "subjectEvent", i), event)
+		}
+	}
+}
+
+func (v *validator) descriptiveMetadata(path string, dm *DescriptiveMetadata) {
+	if dm == nil {
+		return
+	}
+	v.lang(path, dm.Lang, true)
+
+	p := JoinPath(path, "objectClassificationWrap")
+	v.require(JoinPath(p, "objectWorkTypeWrap"), "objectWorkType", len(dm.ObjectClass.WorkType.Types))
+	v.classifications(JoinPath(p, "objectWorkTypeWrap"), "objectWorkType", dm.ObjectClass.WorkType.Types)
+	if dm.ObjectClass.ClassificationWrap != nil {
+		v.classifications(JoinPath(p, "classificationWrap"), "classification",
+			dm.ObjectClass.ClassificationWrap.Classifications)
+	}
+
+	p = JoinPath(path, "objectIdentificationWrap")
+	id := &dm.ObjectID
+	v.require(JoinPath(p, "titleWrap"), "titleSet", len(id.TitleWrap.Titles))
+	for i, title := range id.TitleWrap.Titles {
+		if title != nil {
+			v.appellation(IndexPath(JoinPath(p, "titleWrap"), "titleSet", i), &title.Appellation)
+		}
+	}
+	if id.InscriptionsWrap != nil {
+		for i, inscription := range id.InscriptionsWrap.Inscriptions {
+			if inscription == nil {
+				continue
+			}
+			q := IndexPath(JoinPath(p, "inscriptionsWrap"), "inscriptions", i)
+			v.texts(q, "inscriptionTranscription", inscription.InscriptionTranscriptions)
+			v.descriptiveNotes(q, "inscriptionDescription", inscription.InscriptionDescriptions)
+		}
+	}
+	if id.RepositoryWrap != nil {
+		for i, repository := range id.RepositoryWrap.Repositories {
+			if repository == nil {
+				continue
+			}
+			q := IndexPath(JoinPath(p, "repositoryWrap"), "repositorySet", i)
+			v.legalBody(JoinPath(q, "repositoryName"), repository.RepositoryName)
+			v.place(JoinPath(q, "repositoryLocation"), repository.RepositoryLocation)
+		}
+	}
+	if id.DisplayStateEditionWrap != nil {
+		q := JoinPath(p, "displayStateEditionWrap")
+		v.texts(q, "displayState", id.DisplayStateEditionWrap.DisplayStates)
+		v.texts(q, "displayEdition", id.DisplayStateEditionWrap.DisplayEditions)
+		v.texts(q, "sourceStateEdition", id.DisplayStateEditionWrap.SourceStateEditions)
+	}
+	if id.Description != nil {
+		v.descriptiveNotes(JoinPath(p, "objectDescriptionWrap"), "objectDescriptionSet", id.Description.Notes)
+	}
+	v.measurements(JoinPath(p, "objectMeasurementsWrap"), id.MeasurementsWrap)
+
+	if dm.EventWrap != nil {
+		for i, e := range dm.EventWrap.Events {
+			if e != nil {
+				v.eventElement(IndexPath(JoinPath(path, "eventWrap"), "eventSet", i), e)
+			}
+		}
+	}
+
+	if dm.ObjectRelationWrap != nil {
+		p = JoinPath(path, "objectRelationWrap")
+		if dm.ObjectRelationWrap.SubjectWrap != nil {
+			for i, set := range dm.ObjectRelationWrap.SubjectWrap.SubjectSets {
+				if set == nil {
+					continue
+				}
+				q := IndexPath(JoinPath(p, "subjectWrap"), "subjectSet", i)
+				v.texts(q, "displaySubject", set.DisplaySubjects)
+				if set.Subject != nil {
+					v.subject(JoinPath(q, "subject"), set.Subject)
+				}
+			}
+		}
+		if dm.ObjectRelationWrap.RelatedWorksWrap != nil {
+			for i, set := range dm.ObjectRelationWrap.RelatedWorksWrap.RelatedWorkSets {
+				if set == nil {
+					continue
+				}
+				q := IndexPath(JoinPath(p, "relatedWorksWrap"), "relatedWorkSet", i)
+				v.concept(JoinPath(q, "relatedWorkRelType"), set.RelatedWorkRelType)
+				v.object(JoinPath(q, "relatedWork"), set.RelatedWork)
+			}
+		}
+	}
+}
+
+func (v *validator) rights(path string, r *Rights) {
+	if r == nil {
+		return
+	}
+	v.concepts(path, "rightsType", r.RightsTypes)
+	v.dateSpan(JoinPath(path, "rightsDate"), r.RightsDate)
+	v.legalBodies(path, "rightsHolder", r.RightsHolders)
+	v.texts(path, "creditLine", r.CreditLines)
+}
+
+func (v *validator) administrativeMetadata(path string, am *AdministrativeMetadata) {
+	if am == nil {
+		return
+	}
+	v.lang(path, am.Lang, true)
+
+	if am.RightsWorkWrap != nil {
+		for i, r := range am.RightsWorkWrap.RightsWorkSets {
+			v.rights(IndexPath(JoinPath(path, "rightsWorkWrap"), "rightsWorkSet", i), r)
+		}
+	}
+
+	if am.RecordWrap == nil {
+		v.errorf(path, nil, "missing recordWrap (exactly 1 required)")
+	} else {
+		p := JoinPath(path, "recordWrap")
+		rw := am.RecordWrap
+		v.require(p, "recordID", len(rw.RecordIDs))
+		v.identifiers(p, "recordID", rw.RecordIDs, true)
+		if rw.RecordType == nil {
+			v.errorf(p, nil, "missing recordType (exactly 1 required)")
+		}
+		v.concept(JoinPath(p, "recordType"), rw.RecordType)
+		v.require(p, "recordSource", len(rw.RecordSources))
+		v.legalBodies(p, "recordSource", rw.RecordSources)
+		for i, r := range rw.RecordRights {
+			v.rights(IndexPath(p, "recordRights", i), r)
+		}
+		for i, info := range rw.RecordInfoSets {
+			if info == nil {
+				continue
+			}
+			q := IndexPath(p, "recordInfoSet", i)
+			v.identifiers(q, "recordInfoID", info.RecordInfoIDs, false)
+			v.webResources(q, "recordInfoLink", info.RecordInfoLinks)
+			v.notes(q, "recordMetadataDate", info.RecordMetadataDates)
+		}
+	}
+
+	if am.ResourceWrap != nil {
+		for i, set := range am.ResourceWrap.ResourceSets {
+			if set == nil {
+				continue
+			}
+			p := IndexPath(JoinPath(path, "resourceWrap"), "resourceSet", i)
+			for j, r := range set.RightsResources {
+				v.rights(IndexPath(p, "rightsResource", j), r)
+			}
+			for j, rep := range set.ResourceRepresentations {
+				if rep == nil {
+					continue
+				}
+				q := IndexPath(p, "resourceRepresentation", j)
+				if rep.LinkResource != nil {
+					v.webResource(JoinPath(q, "linkResource"), &rep.LinkResource.WebResource)
+				}
+				for k, m := range rep.ResourceMeasurementsSets {
+					if m != nil {
+						v.texts(IndexPath(q, "resourceMeasurementsSet", k), "displayObjectMeasurements", m.DisplayMeasurements)
+					}
+				}
+			}
+			v.concept(JoinPath(p, "resourceType"), set.ResourceType)
+			v.dateSet(JoinPath(p, "resourceDateTaken"), set.ResourceDateTaken)
+			v.legalBodies(p, "resourceSource", set.ResourceSources)
+			v.identifier(JoinPath(p, "resourceID"), set.ResourceID, false)
+			v.concepts(p, "resourceRelType", set.ResourceRelTypes)
+			v.concepts(p, "resourcePerspective", set.ResourcePerspectives)
+			v.notes(p, "resourceDescription", set.ResourceDescriptions)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package lido
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/verisart/xsd/xsdt"
+)
+
+func validRecord() *Lido {
+	l := &Lido{}
+	l.AppendRecID("DE-Mb112", LocalRecordType, "DE-Mb112/lido-obj00154983")
+
+	desc := l.CreateDesc("en")
+	desc.AppendAATWorkType(URIType, "http://vocab.getty.edu/aat/300033618", "painting")
+	desc.ObjectID.TitleWrap.Append(NewTitle("Primavera", "en", true, RepositoryTitle))
+
+	l.AdministrativeMetadatas = append(l.AdministrativeMetadatas, &AdministrativeMetadata{
+		Lang: "en",
+		RecordWrap: &RecordWrap{
+			RecordIDs:  []*Identifier{{Value: "obj00154983", Type: LocalRecordType}},
+			RecordType: NewURIConcept("http://terminology.lido-schema.org/lido00141", "item", "en"),
+			RecordSources: []*LegalBodyRef{{
+				LegalBodyNames: []*Appellation{{Values: []*AppellationValue{{Value: "Bildarchiv Foto Marburg"}}}},
+			}},
+		},
+	})
+	return l
+}
+
+func TestValidate(t *testing.T) {
+	if err := validRecord().Validate(); err != nil {
+		t.Fatalf("valid record: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(l *Lido)
+		path   string
+		msg    string
+	}{
+		{"no lidoRecID", func(l *Lido) { l.LidoRecIDs = nil },
+			"", "missing lidoRecID (at least 1 required)"},
+		{"lidoRecID type", func(l *Lido) { l.LidoRecIDs[0].Type = "" },
+			"lidoRecID[0]/@type", "missing required attribute"},
+		{"no descriptiveMetadata", func(l *Lido) { l.DescriptiveMetadatas = nil },
+			"", "missing descriptiveMetadata (at least 1 required)"},
+		{"no xml:lang", func(l *Lido) { l.DescriptiveMetadatas[0].Lang = "" },
+			"descriptiveMetadata[0]/@xml:lang", "missing required attribute"},
+		{"bad xml:lang", func(l *Lido) { l.DescriptiveMetadatas[0].Lang = "english" },
+			"descriptiveMetadata[0]/@xml:lang", `invalid language tag "english"`},
+		{"no objectWorkType", func(l *Lido) { l.DescriptiveMetadatas[0].ObjectClass.WorkType.Types = nil },
+			"descriptiveMetadata[0]/objectClassificationWrap/objectWorkTypeWrap", "missing objectWorkType (at least 1 required)"},
+		{"no titleSet", func(l *Lido) { l.DescriptiveMetadatas[0].ObjectID.TitleWrap.Titles = nil },
+			"descriptiveMetadata[0]/objectIdentificationWrap/titleWrap", "missing titleSet (at least 1 required)"},
+		{"pref", func(l *Lido) { l.DescriptiveMetadatas[0].ObjectID.TitleWrap.Titles[0].Values[0].Pref = "yes" },
+			"descriptiveMetadata[0]/objectIdentificationWrap/titleWrap/titleSet[0]/appellationValue[0]/@pref",
+			`invalid value "yes" (must be preferred or alternate)`},
+		{"addedSearchTerm", func(l *Lido) {
+			l.DescriptiveMetadatas[0].ObjectClass.WorkType.Types[0].Terms[0].AddedSearchTerm = "true"
+		}, "descriptiveMetadata[0]/objectClassificationWrap/objectWorkTypeWrap/objectWorkType[0]/term[0]/@addedSearchTerm",
+			`invalid value "true" (must be yes or no)`},
+		{"eventType", func(l *Lido) {
+			l.DescriptiveMetadatas[0].EventWrap = &EventWrap{}
+			l.DescriptiveMetadatas[0].EventWrap.AppendEvent(&Event{})
+		}, "descriptiveMetadata[0]/eventWrap/eventSet[0]/event", "found 0 eventType elements (exactly 1 required)"},
+		{"no administrativeMetadata", func(l *Lido) { l.AdministrativeMetadatas = nil },
+			"", "missing administrativeMetadata (at least 1 required)"},
+		{"no recordType", func(l *Lido) { l.AdministrativeMetadatas[0].RecordWrap.RecordType = nil },
+			"administrativeMetadata[0]/recordWrap", "missing recordType (exactly 1 required)"},
+		{"empty recordSource", func(l *Lido) { l.AdministrativeMetadatas[0].RecordWrap.RecordSources[0].LegalBodyNames = nil },
+			"administrativeMetadata[0]/recordWrap/recordSource[0]",
+			"missing legalBodyID, legalBodyName or legalBodyWeblink (at least 1 required)"},
+	}
+
+	for _, test := range tests {
+		l := validRecord()
+		test.modify(l)
+		errs := validationErrors(l)
+		if len(errs) != 1 {
+			t.Errorf("%s: got %d errors, want 1: %v", test.name, len(errs), errs)
+			continue
+		}
+		if errs[0].Path != test.path || errs[0].Msg != test.msg {
+			t.Errorf("%s: got %q: %q, want %q: %q", test.name, errs[0].Path, errs[0].Msg, test.path, test.msg)
+		}
+	}
+
+	l := validRecord()
+	l.DescriptiveMetadatas[0].Lang = "en-"
+	var verr *xsdt.ValidationError
+	if errs := validationErrors(l); len(errs) != 1 || !errors.As(errs[0], &verr) {
+		t.Errorf("language error does not wrap an xsdt.ValidationError: %v", errs)
+	}
+
+	var none *Lido
+	if errs := validationErrors(none); len(errs) != 1 || errs[0].Msg != "missing lido record" {
+		t.Errorf("nil record: %v", errs)
+	}
+}
+
+// The errors reported by l.Validate, or nil.
+func validationErrors(l *Lido) ValidationErrors {
+	var errs ValidationErrors
+	errors.As(l.Validate(), &errs)
+	return errs
+}
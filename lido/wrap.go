@@ -0,0 +1,114 @@
+package lido
+
+import (
+	"errors"
+	"io"
+
+	"github.com/juju/xml"
+)
+
+// A collection of LIDO records, the root element of most harvests and
+// exports. Unmarshalling a LidoWrap loads every record into memory; use a
+// Decoder and an Encoder to process large collections one record at a time.
+type LidoWrap struct {
+	XMLName xml.Name `xml:"http://www.lido-schema.org lidoWrap" json:"-"`
+
+	// The records of the collection.
+	Lidos []*Lido `xml:"http://www.lido-schema.org lido" json:"lido,omitempty"`
+}
+
+// Reads LIDO records one at a time from an XML stream, holding only the
+// record being decoded in memory.
+type Decoder struct {
+	d   *xml.Decoder
+	err error
+}
+
+// Returns a Decoder reading from r. The stream may be a lidoWrap document, a
+// single lido record, or any other document with lido records inside it, such
+// as an OAI-PMH response.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{d: xml.NewDecoder(r)}
+}
+
+// Decodes the next lido element in the stream. It returns io.EOF when there
+// are no more records. Once Decode has failed, it keeps returning the same
+// error.
+func (dec *Decoder) Decode() (*Lido, error) {
+	if dec.err != nil {
+		return nil, dec.err
+	}
+	for {
+		token, err := dec.d.Token()
+		if err != nil {
+			dec.err = err
+			return nil, err
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Space != Namespace || start.Name.Local != "lido" {
+			continue
+		}
+		l := &Lido{}
+		if err := dec.d.DecodeElement(l, &start); err != nil {
+			dec.err = err
+			return nil, err
+		}
+		return l, nil
+	}
+}
+
+var errEncoderClosed = errors.New("lido: Encode called after Close")
+
+// Writes LIDO records one at a time as a lidoWrap document. The lidoWrap
+// start tag is written with the first record, and Close writes the end tag.
+type Encoder struct {
+	e       *xml.Encoder
+	started bool
+	closed  bool
+}
+
+// Returns an Encoder writing to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{e: xml.NewEncoder(w)}
+}
+
+// Sets the encoder to indent the output, see xml.Encoder.Indent.
+func (enc *Encoder) Indent(prefix, indent string) {
+	enc.e.Indent(prefix, indent)
+}
+
+func (enc *Encoder) start() error {
+	if enc.started {
+		return nil
+	}
+	enc.started = true
+	return enc.e.EncodeToken(xml.StartElement{Name: xml.Name{Space: Namespace, Local: "lidoWrap"}})
+}
+
+// Writes a record to the stream and flushes it to the underlying writer.
+func (enc *Encoder) Encode(l *Lido) error {
+	if enc.closed {
+		return errEncoderClosed
+	}
+	if err := enc.start(); err != nil {
+		return err
+	}
+	return enc.e.EncodeElement(l, xml.StartElement{Name: xml.Name{Space: Namespace, Local: "lido"}})
+}
+
+// Ends the lidoWrap document and flushes it to the underlying writer. It
+// does not close the writer. An empty lidoWrap is written if no record was
+// encoded.
+func (enc *Encoder) Close() error {
+	if enc.closed {
+		return nil
+	}
+	if err := enc.start(); err != nil {
+		return err
+	}
+	enc.closed = true
+	if err := enc.e.EncodeToken(xml.EndElement{Name: xml.Name{Space: Namespace, Local: "lidoWrap"}}); err != nil {
+		return err
+	}
+	return enc.e.Flush()
+}
@@ -0,0 +1,84 @@
+package lido
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/juju/xml"
+)
+
+func TestLidoWrapStream(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	ids := []string{"rec-1", "rec-2", "rec-3"}
+	for _, id := range ids {
+		l := &Lido{}
+		l.AppendRecID("test", LocalRecordType, id)
+		if err := enc.Encode(l); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.Encode(&Lido{}); err == nil {
+		t.Error("Encode after Close succeeded")
+	}
+
+	out := buf.String()
+	if !strings.HasPrefix(out, `<lidoWrap xmlns="http://www.lido-schema.org">`) || !strings.HasSuffix(out, `</lidoWrap>`) {
+		t.Errorf("unexpected document: %s", out)
+	}
+
+	var wrap LidoWrap
+	if err := xml.Unmarshal(buf.Bytes(), &wrap); err != nil {
+		t.Fatal(err)
+	}
+	if len(wrap.Lidos) != len(ids) {
+		t.Fatalf("unmarshalled %d records, want %d", len(wrap.Lidos), len(ids))
+	}
+
+	dec := NewDecoder(&buf)
+	for _, id := range ids {
+		l, err := dec.Decode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := string(l.LidoRecIDs[0].Value); got != id {
+			t.Errorf("decoded record %q, want %q", got, id)
+		}
+	}
+	if _, err := dec.Decode(); err != io.EOF {
+		t.Errorf("got %v after last record, want io.EOF", err)
+	}
+}
+
+func TestDecoderEnvelope(t *testing.T) {
+	doc := `<OAI-PMH><ListRecords>` +
+		`<record><metadata><lido:lido xmlns:lido="http://www.lido-schema.org"><lido:lidoRecID lido:type="local">a</lido:lidoRecID></lido:lido></metadata></record>` +
+		`<record><metadata><lido xmlns="http://www.lido-schema.org"><lidoRecID type="local">b</lidoRecID></lido></metadata></record>` +
+		`</ListRecords></OAI-PMH>`
+	dec := NewDecoder(strings.NewReader(doc))
+	for _, id := range []string{"a", "b"} {
+		l, err := dec.Decode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := string(l.LidoRecIDs[0].Value); got != id {
+			t.Errorf("decoded record %q, want %q", got, id)
+		}
+	}
+	if _, err := dec.Decode(); err != io.EOF {
+		t.Errorf("got %v after last record, want io.EOF", err)
+	}
+
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), `<lidoWrap xmlns="http://www.lido-schema.org"></lidoWrap>`; got != want {
+		t.Errorf("empty wrap: got %s, want %s", got, want)
+	}
+}
@@ -172,7 +172,7 @@ type MetsHdr struct {
 	// the METS document that contain administrative metadata pertaining to the
 	// METS document itself.  For more information on using METS IDREFS and IDREF
 	// type attributes for internal linking, see Chapter 4 of the METS Primer.
-	AdmID xsdt.Idrefs `xml:"ADMID,attr,omitempty"`
+	AdmID xsdt.IdrefList `xml:"ADMID,attr,omitempty"`
 }
 
 //  The alternative record identifier element <altRecordID> allows one to use
@@ -216,10 +216,55 @@ type MetsOtherType xsdt.String
 //  INDIVIDUAL | ORGANIZATION | OTHER
 type MetsAgentType xsdt.String
 
+// The values allowed for TYPE.
+const (
+	MetsAgentTypeIndividual   MetsAgentType = "INDIVIDUAL"
+	MetsAgentTypeOrganization MetsAgentType = "ORGANIZATION"
+	MetsAgentTypeOther        MetsAgentType = "OTHER"
+)
+
+// The TYPE enumeration, as a restriction of xs:string.
+var MetsAgentTypeRestriction = xsdt.MustRestriction("TYPE", "string", xsdt.Enumeration("INDIVIDUAL", "ORGANIZATION", "OTHER"))
+
+// Checks the value against MetsAgentTypeRestriction.
+func (me MetsAgentType) Validate() error {
+	return MetsAgentTypeRestriction.Validate(string(me))
+}
+
+// Reports whether Validate succeeds.
+func (me MetsAgentType) IsValid() bool {
+	return me.Validate() == nil
+}
+
 // CREATOR | EDITOR | ARCHIVIST | PRESERVATION | DISSEMINATOR | CUSTODIAN |
 // IPOWNER | OTHER
 type MetsAgentRoleType xsdt.String
 
+// The values allowed for ROLE.
+const (
+	MetsAgentRoleCreator      MetsAgentRoleType = "CREATOR"
+	MetsAgentRoleEditor       MetsAgentRoleType = "EDITOR"
+	MetsAgentRoleArchivist    MetsAgentRoleType = "ARCHIVIST"
+	MetsAgentRolePreservation MetsAgentRoleType = "PRESERVATION"
+	MetsAgentRoleDisseminator MetsAgentRoleType = "DISSEMINATOR"
+	MetsAgentRoleCustodian    MetsAgentRoleType = "CUSTODIAN"
+	MetsAgentRoleIPOwner      MetsAgentRoleType = "IPOWNER"
+	MetsAgentRoleOther        MetsAgentRoleType = "OTHER"
+)
+
+// The ROLE enumeration, as a restriction of xs:string.
+var MetsAgentRoleTypeRestriction = xsdt.MustRestriction("ROLE", "string", xsdt.Enumeration("CREATOR", "EDITOR", "ARCHIVIST", "PRESERVATION", "DISSEMINATOR", "CUSTODIAN", "IPOWNER", "OTHER"))
+
+// Checks the value against MetsAgentRoleTypeRestriction.
+func (me MetsAgentRoleType) Validate() error {
+	return MetsAgentRoleTypeRestriction.Validate(string(me))
+}
+
+// Reports whether Validate succeeds.
+func (me MetsAgentRoleType) IsValid() bool {
+	return me.Validate() == nil
+}
+
 type MetsAgent struct {
 
 	//  The <note> element can be used to record any additional information
@@ -303,7 +348,7 @@ type MetsFileGrp struct {
 	// the METS document that contain administrative metadata pertaining to the
 	// METS document itself.  For more information on using METS IDREFS and IDREF
 	// type attributes for internal linking, see Chapter 4 of the METS Primer.
-	AdmID xsdt.Idrefs `xml:"ADMID,attr,omitempty"`
+	AdmID xsdt.IdrefList `xml:"ADMID,attr,omitempty"`
 
 	//  USE (string/O): A tagging attribute to indicate the intended use of files
 	// within this file group (e.g., master, reference, thumbnails for image
@@ -394,14 +439,14 @@ type MetsFile struct {
 	// the METS document that contain administrative metadata pertaining to the
 	// METS document itself.  For more information on using METS IDREFS and IDREF
 	// type attributes for internal linking, see Chapter 4 of the METS Primer.
-	AdmID xsdt.Idrefs `xml:"ADMID,attr,omitempty"`
+	AdmID xsdt.IdrefList `xml:"ADMID,attr,omitempty"`
 
 	//  DMDID (IDREFS/O): Contains the ID attribute values identifying the
 	// <dmdSec>, elements in the METS document that contain or link to descriptive
 	// metadata pertaining to the content file stream represented by the current
 	// <stream> element.  For more information on using METS IDREFS and IDREF type
 	// attributes for internal linking, see Chapter 4 of the METS Primer.
-	DmdID xsdt.Idrefs `xml:"DMDID,attr,omitempty"`
+	DmdID xsdt.IdrefList `xml:"DMDID,attr,omitempty"`
 
 	//  USE (string/O): A tagging attribute to indicate the intended use of the
 	// specific copy of the file represented by the <FContent> element (e.g.,
@@ -460,6 +505,25 @@ type MetsFile struct {
 
 type MetsTransformType xsdt.String
 
+// The values allowed for TRANSFORMTYPE.
+const (
+	MetsTransformDecompression MetsTransformType = "decompression"
+	MetsTransformDecryption    MetsTransformType = "decryption"
+)
+
+// The TRANSFORMTYPE enumeration, as a restriction of xs:string.
+var MetsTransformTypeRestriction = xsdt.MustRestriction("TRANSFORMTYPE", "string", xsdt.Enumeration("decompression", "decryption"))
+
+// Checks the value against MetsTransformTypeRestriction.
+func (me MetsTransformType) Validate() error {
+	return MetsTransformTypeRestriction.Validate(string(me))
+}
+
+// Reports whether Validate succeeds.
+func (me MetsTransformType) IsValid() bool {
+	return me.Validate() == nil
+}
+
 type MetsTransformFile struct {
 	//  TRANSFORMBEHAVIOR (string/O): An IDREF to a behavior element for this
 	// transformation.
@@ -535,6 +599,36 @@ type MetsFileContent struct {
 // BYTE | IDREF | SMIL | MIDI | SMPTE-25 | SMPTE-24 | SMPTE-DF30 | SMPTE-NDF30 | SMPTE-DF29.97 | SMPTE-NDF29.97 | TIME | TCF | XPTR
 type MetsBEType xsdt.String
 
+// The values allowed for BETYPE.
+const (
+	MetsBETypeByte         MetsBEType = "BYTE"
+	MetsBETypeIdref        MetsBEType = "IDREF"
+	MetsBETypeSmil         MetsBEType = "SMIL"
+	MetsBETypeMidi         MetsBEType = "MIDI"
+	MetsBETypeSmpte25      MetsBEType = "SMPTE-25"
+	MetsBETypeSmpte24      MetsBEType = "SMPTE-24"
+	MetsBETypeSmpteDF30    MetsBEType = "SMPTE-DF30"
+	MetsBETypeSmpteNDF30   MetsBEType = "SMPTE-NDF30"
+	MetsBETypeSmpteDF2997  MetsBEType = "SMPTE-DF29.97"
+	MetsBETypeSmpteNDF2997 MetsBEType = "SMPTE-NDF29.97"
+	MetsBETypeTime         MetsBEType = "TIME"
+	MetsBETypeTcf          MetsBEType = "TCF"
+	MetsBETypeXptr         MetsBEType = "XPTR"
+)
+
+// The BETYPE enumeration, as a restriction of xs:string.
+var MetsBETypeRestriction = xsdt.MustRestriction("BETYPE", "string", xsdt.Enumeration("BYTE", "IDREF", "SMIL", "MIDI", "SMPTE-25", "SMPTE-24", "SMPTE-DF30", "SMPTE-NDF30", "SMPTE-DF29.97", "SMPTE-NDF29.97", "TIME", "TCF", "XPTR"))
+
+// Checks the value against MetsBETypeRestriction.
+func (me MetsBEType) Validate() error {
+	return MetsBETypeRestriction.Validate(string(me))
+}
+
+// Reports whether Validate succeeds.
+func (me MetsBEType) IsValid() bool {
+	return me.Validate() == nil
+}
+
 type MetsStream struct {
 
 	//  BEGIN (string/O): An attribute that specifies the point in the parent
@@ -573,7 +667,7 @@ type MetsStream struct {
 	// the METS document that contain administrative metadata pertaining to the
 	// METS document itself.  For more information on using METS IDREFS and IDREF
 	// type attributes for internal linking, see Chapter 4 of the METS Primer.
-	AdmID xsdt.Idrefs `xml:"ADMID,attr,omitempty"`
+	AdmID xsdt.IdrefList `xml:"ADMID,attr,omitempty"`
 
 	// TODO: not sure if this is for the xml content?
 	//XsdtAnyType
@@ -583,7 +677,7 @@ type MetsStream struct {
 	// metadata pertaining to the content file stream represented by the current
 	// <stream> element.  For more information on using METS IDREFS and IDREF type
 	// attributes for internal linking, see Chapter 4 of the METS Primer.
-	DmdID xsdt.Idrefs `xml:"DMDID,attr,omitempty"`
+	DmdID xsdt.IdrefList `xml:"DMDID,attr,omitempty"`
 
 	//  streamType (string/O): The IANA MIME media type for the bytestream.
 	StreamType xsdt.String `xml:"streamType,attr,omitempty"`
@@ -596,6 +690,34 @@ type MetsStream struct {
 
 type MetsChecksumType xsdt.String
 
+// The values allowed for CHECKSUMTYPE.
+const (
+	MetsChecksumAdler32   MetsChecksumType = "Adler-32"
+	MetsChecksumCRC32     MetsChecksumType = "CRC32"
+	MetsChecksumHAVAL     MetsChecksumType = "HAVAL"
+	MetsChecksumMD5       MetsChecksumType = "MD5"
+	MetsChecksumMNP       MetsChecksumType = "MNP"
+	MetsChecksumSHA1      MetsChecksumType = "SHA-1"
+	MetsChecksumSHA256    MetsChecksumType = "SHA-256"
+	MetsChecksumSHA384    MetsChecksumType = "SHA-384"
+	MetsChecksumSHA512    MetsChecksumType = "SHA-512"
+	MetsChecksumTIGER     MetsChecksumType = "TIGER"
+	MetsChecksumWHIRLPOOL MetsChecksumType = "WHIRLPOOL"
+)
+
+// The CHECKSUMTYPE enumeration, as a restriction of xs:string.
+var MetsChecksumTypeRestriction = xsdt.MustRestriction("CHECKSUMTYPE", "string", xsdt.Enumeration("Adler-32", "CRC32", "HAVAL", "MD5", "MNP", "SHA-1", "SHA-256", "SHA-384", "SHA-512", "TIGER", "WHIRLPOOL"))
+
+// Checks the value against MetsChecksumTypeRestriction.
+func (me MetsChecksumType) Validate() error {
+	return MetsChecksumTypeRestriction.Validate(string(me))
+}
+
+// Reports whether Validate succeeds.
+func (me MetsChecksumType) IsValid() bool {
+	return me.Validate() == nil
+}
+
 type MetsFileCore struct {
 
 	// MIMETYPE (string/O): The IANA MIME media type for the associated file or
@@ -667,6 +789,30 @@ type MetsFLocat struct {
 //  OTHER
 type MetsLocationLocType xsdt.String
 
+// The values allowed for LOCTYPE.
+const (
+	MetsLocTypeARK    MetsLocationLocType = "ARK"
+	MetsLocTypeURN    MetsLocationLocType = "URN"
+	MetsLocTypeURL    MetsLocationLocType = "URL"
+	MetsLocTypePURL   MetsLocationLocType = "PURL"
+	MetsLocTypeHANDLE MetsLocationLocType = "HANDLE"
+	MetsLocTypeDOI    MetsLocationLocType = "DOI"
+	MetsLocTypeOther  MetsLocationLocType = "OTHER"
+)
+
+// The LOCTYPE enumeration, as a restriction of xs:string.
+var MetsLocationLocTypeRestriction = xsdt.MustRestriction("LOCTYPE", "string", xsdt.Enumeration("ARK", "URN", "URL", "PURL", "HANDLE", "DOI", "OTHER"))
+
+// Checks the value against MetsLocationLocTypeRestriction.
+func (me MetsLocationLocType) Validate() error {
+	return MetsLocationLocTypeRestriction.Validate(string(me))
+}
+
+// Reports whether Validate succeeds.
+func (me MetsLocationLocType) IsValid() bool {
+	return me.Validate() == nil
+}
+
 type MetsLocation struct {
 	//  LOCTYPE (string/R): Specifies the locator type used in the xlink:href
 	// attribute.
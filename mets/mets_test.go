@@ -45,3 +45,52 @@ func TestMarshal(t *testing.T) {
 		}
 	}
 }
+
+func TestIdrefLists(t *testing.T) {
+	grp := &MetsFileGrp{ID: "GRP1"}
+	grp.AdmID.Append("AMD1")
+	file := &MetsFile{ID: "FILE1"}
+	file.AdmID.Append("AMD1", "AMD2")
+	file.DmdID.AppendUnique("DMD1", "DMD1")
+	grp.Files = append(grp.Files, file)
+
+	data, err := xml.Marshal(grp)
+	if err != nil {
+		t.Fatalf("Marshal: %s", err)
+	}
+	for _, want := range []string{` ADMID="AMD1"`, ` ADMID="AMD1 AMD2"`, ` DMDID="DMD1"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Marshal: %s lacks %s", data, want)
+		}
+	}
+
+	var back MetsFileGrp
+	if err := xml.Unmarshal(data, &back); err != nil {
+		t.Fatalf("Unmarshal: %s", err)
+	}
+	got := back.Files[0]
+	if len(got.AdmID) != 2 || !got.AdmID.Contains("AMD2") || !got.DmdID.Contains("DMD1") {
+		t.Fatalf("Unmarshal: have ADMID %q, DMDID %q", got.AdmID, got.DmdID)
+	}
+	if !got.AdmID.Remove("AMD1") || got.AdmID.String() != "AMD2" || got.DmdID.Remove("AMD1") {
+		t.Errorf("Remove: have ADMID %q, DMDID %q", got.AdmID, got.DmdID)
+	}
+	got.DmdID.Remove("DMD1")
+	if data, _ = xml.Marshal(got); strings.Contains(string(data), "DMDID") {
+		t.Errorf("Marshal: empty DMDID not omitted in %s", data)
+	}
+}
+
+func TestEnumerations(t *testing.T) {
+	loc := MetsLocation{LocType: MetsLocTypeURL}
+	if err := loc.LocType.Validate(); err != nil {
+		t.Errorf("Validate: %s", err)
+	}
+	if MetsChecksumType("SHA256").IsValid() || !MetsChecksumSHA256.IsValid() {
+		t.Errorf("IsValid: CHECKSUMTYPE enumeration not applied")
+	}
+	err := MetsAgentRoleType("AUTHOR").Validate()
+	if err == nil || !strings.Contains(err.Error(), "ROLE") || !strings.Contains(err.Error(), "enumeration") {
+		t.Errorf("Validate: have %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+package oaipmh
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Harvests an OAI-PMH repository.
+type Client struct {
+	// The base URL of the repository.
+	BaseURL string
+
+	// The client used for requests; http.DefaultClient if nil.
+	HTTPClient *http.Client
+
+	// The granularity of the from and until arguments; GranularitySeconds
+	// if empty. Set it to GranularityDay for repositories that only support
+	// days.
+	Granularity string
+}
+
+// Returns a client for the repository with the specified base URL.
+func NewClient(baseURL string) *Client {
+	return &Client{BaseURL: baseURL}
+}
+
+// Sends a request and decodes the response, returning the first error it
+// reports.
+func (c *Client) do(args url.Values) (*Response, error) {
+	u, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = args.Encode()
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	httpResp, err := client.Get(u.String())
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oaipmh: %s: %s", args.Get("verb"), httpResp.Status)
+	}
+	resp := &Response{}
+	if err := xml.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Errors) > 0 {
+		return nil, resp.Errors[0]
+	}
+	return resp, nil
+}
+
+func (c *Client) rangeArgs(verb, prefix string, from, until time.Time) url.Values {
+	args := url.Values{"verb": {verb}, "metadataPrefix": {prefix}}
+	if !from.IsZero() {
+		args.Set("from", FormatDatestamp(from, c.Granularity))
+	}
+	if !until.IsZero() {
+		args.Set("until", FormatDatestamp(until, c.Granularity))
+	}
+	return args
+}
+
+// Returns information about the repository.
+func (c *Client) Identify() (*Identify, error) {
+	resp, err := c.do(url.Values{"verb": {VerbIdentify}})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Identify == nil {
+		return nil, fmt.Errorf("oaipmh: %s response without %s element", VerbIdentify, VerbIdentify)
+	}
+	return resp.Identify, nil
+}
+
+// Returns the metadata formats of the repository or, if identifier is not
+// empty, of the identified item.
+func (c *Client) ListMetadataFormats(identifier string) ([]*MetadataFormat, error) {
+	args := url.Values{"verb": {VerbListMetadataFormats}}
+	if identifier != "" {
+		args.Set("identifier", identifier)
+	}
+	resp, err := c.do(args)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ListMetadataFormats == nil {
+		return nil, fmt.Errorf("oaipmh: %s response without %s element", VerbListMetadataFormats, VerbListMetadataFormats)
+	}
+	return resp.ListMetadataFormats.MetadataFormats, nil
+}
+
+// Returns the identified item in the format with the specified prefix.
+func (c *Client) GetRecord(identifier, prefix string) (*Record, error) {
+	resp, err := c.do(url.Values{"verb": {VerbGetRecord}, "identifier": {identifier}, "metadataPrefix": {prefix}})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetRecord == nil || resp.GetRecord.Record == nil {
+		return nil, fmt.Errorf("oaipmh: %s response without record", VerbGetRecord)
+	}
+	return resp.GetRecord.Record, nil
+}
+
+// Calls fn with the header of each item in the format with the specified
+// prefix whose datestamp lies between from and until, following resumption
+// tokens until the list is complete or fn returns an error. A zero time
+// leaves that end of the range open. A noRecordsMatch response is not an
+// error.
+func (c *Client) ListIdentifiers(prefix string, from, until time.Time, fn func(*Header) error) error {
+	args := c.rangeArgs(VerbListIdentifiers, prefix, from, until)
+	for {
+		resp, err := c.do(args)
+		if e, ok := err.(*Error); ok && e.Code == NoRecordsMatch {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if resp.ListIdentifiers == nil {
+			return fmt.Errorf("oaipmh: %s response without %s element", VerbListIdentifiers, VerbListIdentifiers)
+		}
+		for _, header := range resp.ListIdentifiers.Headers {
+			if err := fn(header); err != nil {
+				return err
+			}
+		}
+		token := resp.ListIdentifiers.ResumptionToken
+		if token == nil || token.Token == "" {
+			return nil
+		}
+		args = url.Values{"verb": {VerbListIdentifiers}, "resumptionToken": {token.Token}}
+	}
+}
+
+// Calls fn with each item in the format with the specified prefix whose
+// datestamp lies between from and until, like ListIdentifiers.
+func (c *Client) ListRecords(prefix string, from, until time.Time, fn func(*Record) error) error {
+	args := c.rangeArgs(VerbListRecords, prefix, from, until)
+	for {
+		resp, err := c.do(args)
+		if e, ok := err.(*Error); ok && e.Code == NoRecordsMatch {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if resp.ListRecords == nil {
+			return fmt.Errorf("oaipmh: %s response without %s element", VerbListRecords, VerbListRecords)
+		}
+		for _, rec := range resp.ListRecords.Records {
+			if err := fn(rec); err != nil {
+				return err
+			}
+		}
+		token := resp.ListRecords.ResumptionToken
+		if token == nil || token.Token == "" {
+			return nil
+		}
+		args = url.Values{"verb": {VerbListRecords}, "resumptionToken": {token.Token}}
+	}
+}
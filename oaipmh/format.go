@@ -0,0 +1,137 @@
+package oaipmh
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	juju "github.com/juju/xml"
+	"github.com/verisart/xsd/dc"
+	"github.com/verisart/xsd/lido"
+	"github.com/verisart/xsd/mets"
+)
+
+// A metadata format a Server can disseminate, with the functions converting
+// records from and to XML.
+type Format struct {
+	MetadataFormat
+
+	// Returns the XML of a record, which must declare its namespaces.
+	Marshal func(v interface{}) ([]byte, error)
+
+	// Decodes the XML of a record.
+	Unmarshal func(data []byte) (interface{}, error)
+
+	// The prefix of the stored items the format is made from, if not
+	// MetadataPrefix. Marshal is then given those items and converts them on
+	// the fly, and the format is listed for every item stored under Source.
+	Source string
+}
+
+// Returns the prefix of the stored items the format is disseminated from.
+func (f *Format) source() string {
+	if f.Source != "" {
+		return f.Source
+	}
+	return f.MetadataPrefix
+}
+
+// LIDO 1.0 records, held as *lido.Lido.
+var LidoFormat = &Format{
+	MetadataFormat: MetadataFormat{
+		MetadataPrefix:    "lido",
+		Schema:            "http://www.lido-schema.org/schema/v1.0/lido-v1.0.xsd",
+		MetadataNamespace: lido.Namespace,
+	},
+	Marshal: func(v interface{}) ([]byte, error) {
+		if _, ok := v.(*lido.Lido); !ok {
+			return nil, fmt.Errorf("oaipmh: cannot marshal %T as lido", v)
+		}
+		return juju.Marshal(v)
+	},
+	Unmarshal: func(data []byte) (interface{}, error) {
+		l := &lido.Lido{}
+		if err := juju.Unmarshal(data, l); err != nil {
+			return nil, err
+		}
+		return l, nil
+	},
+}
+
+// METS 1.8 documents, held as *mets.Mets.
+var MetsFormat = &Format{
+	MetadataFormat: MetadataFormat{
+		MetadataPrefix:    "mets",
+		Schema:            "http://www.loc.gov/standards/mets/mets.xsd",
+		MetadataNamespace: "http://www.loc.gov/METS/",
+	},
+	Marshal: func(v interface{}) ([]byte, error) {
+		if _, ok := v.(*mets.Mets); !ok {
+			return nil, fmt.Errorf("oaipmh: cannot marshal %T as mets", v)
+		}
+		return xml.Marshal(v)
+	},
+	Unmarshal: func(data []byte) (interface{}, error) {
+		m := &mets.Mets{}
+		if err := xml.Unmarshal(data, m); err != nil {
+			return nil, err
+		}
+		return m, nil
+	},
+}
+
+// Simple Dublin Core records, the format every OAI-PMH repository must
+// support, made from the items stored under the lido prefix with
+// dc.FromLido. To serve items stored under oai_dc instead, use a copy with an
+// empty Source; Marshal accepts a *dc.OaiDC as well as a *lido.Lido.
+// Unmarshal returns a *dc.OaiDC.
+var DCFormat = &Format{
+	MetadataFormat: MetadataFormat{
+		MetadataPrefix:    "oai_dc",
+		Schema:            dc.OaiDCSchema,
+		MetadataNamespace: dc.OaiDCNamespace,
+	},
+	Source: "lido",
+	Marshal: func(v interface{}) ([]byte, error) {
+		switch r := v.(type) {
+		case *dc.OaiDC:
+			return xml.Marshal(r)
+		case *lido.Lido:
+			return xml.Marshal(dc.FromLido(r))
+		}
+		return nil, fmt.Errorf("oaipmh: cannot marshal %T as oai_dc", v)
+	},
+	Unmarshal: func(data []byte) (interface{}, error) {
+		r := &dc.OaiDC{}
+		if err := xml.Unmarshal(data, r); err != nil {
+			return nil, err
+		}
+		return r, nil
+	},
+}
+
+// Decodes the metadata as a simple Dublin Core record.
+func (m *Metadata) DC() (*dc.OaiDC, error) {
+	v, err := m.Decode(DCFormat)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*dc.OaiDC), nil
+}
+
+// Decodes the metadata as a LIDO record.
+func (m *Metadata) Lido() (*lido.Lido, error) {
+	v, err := m.Decode(LidoFormat)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*lido.Lido), nil
+}
+
+// Decodes the metadata as a METS document.
+func (m *Metadata) Mets() (*mets.Mets, error) {
+	v, err := m.Decode(MetsFormat)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*mets.Mets), nil
+}
@@ -0,0 +1,238 @@
+// Package oaipmh implements the Open Archives Initiative Protocol for Metadata
+// Harvesting, version 2.0 (http://www.openarchives.org/OAI/openarchivesprotocol.html),
+// for serving and harvesting LIDO and METS records.
+package oaipmh
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// The namespace of OAI-PMH responses.
+const Namespace = "http://www.openarchives.org/OAI/2.0/"
+
+// The protocol version reported by Identify.
+const ProtocolVersion = "2.0"
+
+// The verbs (requests) of the protocol. ListSets is answered with a
+// noSetHierarchy error, as sets are not supported.
+const (
+	VerbIdentify            = "Identify"
+	VerbListMetadataFormats = "ListMetadataFormats"
+	VerbListSets            = "ListSets"
+	VerbListIdentifiers     = "ListIdentifiers"
+	VerbListRecords         = "ListRecords"
+	VerbGetRecord           = "GetRecord"
+)
+
+// The error codes of the protocol.
+const (
+	BadArgument             = "badArgument"
+	BadResumptionToken      = "badResumptionToken"
+	BadVerb                 = "badVerb"
+	CannotDisseminateFormat = "cannotDisseminateFormat"
+	IdDoesNotExist          = "idDoesNotExist"
+	NoRecordsMatch          = "noRecordsMatch"
+	NoMetadataFormats       = "noMetadataFormats"
+	NoSetHierarchy          = "noSetHierarchy"
+)
+
+// The datestamp granularities of the protocol.
+const (
+	GranularityDay     = "YYYY-MM-DD"
+	GranularitySeconds = "YYYY-MM-DDThh:mm:ssZ"
+)
+
+// The values of the deletedRecord element of Identify.
+const (
+	DeletedNo         = "no"
+	DeletedTransient  = "transient"
+	DeletedPersistent = "persistent"
+)
+
+const (
+	dayLayout     = "2006-01-02"
+	secondsLayout = "2006-01-02T15:04:05Z"
+)
+
+// Formats a datestamp with the specified granularity, in UTC.
+func FormatDatestamp(t time.Time, granularity string) string {
+	if granularity == GranularityDay {
+		return t.UTC().Format(dayLayout)
+	}
+	return t.UTC().Format(secondsLayout)
+}
+
+// Parses a datestamp of either granularity, reporting whether it is a day.
+func ParseDatestamp(s string) (t time.Time, day bool, err error) {
+	if len(s) == len(dayLayout) {
+		t, err = time.Parse(dayLayout, s)
+		return t, true, err
+	}
+	t, err = time.Parse(secondsLayout, s)
+	return t, false, err
+}
+
+// An OAI-PMH error condition. Stores return the predefined values, e.g.
+// ErrIdDoesNotExist, and the Client returns the errors in responses.
+type Error struct {
+	// One of the error codes, e.g. IdDoesNotExist.
+	Code string `xml:"code,attr"`
+
+	Message string `xml:",chardata"`
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return "oaipmh: " + e.Code
+	}
+	return "oaipmh: " + e.Code + ": " + e.Message
+}
+
+var (
+	ErrIdDoesNotExist          = &Error{Code: IdDoesNotExist, Message: "No matching identifier in this repository"}
+	ErrCannotDisseminateFormat = &Error{Code: CannotDisseminateFormat, Message: "The metadata format is not supported by the item or by the repository"}
+	ErrNoMetadataFormats       = &Error{Code: NoMetadataFormats, Message: "There are no metadata formats available for the item"}
+)
+
+// The root element of every response.
+type Response struct {
+	XMLName xml.Name `xml:"http://www.openarchives.org/OAI/2.0/ OAI-PMH"`
+
+	ResponseDate string `xml:"responseDate"`
+
+	Request Request `xml:"request"`
+
+	Errors []*Error `xml:"error"`
+
+	Identify *Identify `xml:"Identify"`
+
+	ListMetadataFormats *ListMetadataFormats `xml:"ListMetadataFormats"`
+
+	ListIdentifiers *ListIdentifiers `xml:"ListIdentifiers"`
+
+	ListRecords *ListRecords `xml:"ListRecords"`
+
+	GetRecord *GetRecord `xml:"GetRecord"`
+}
+
+// Echoes the request a response answers. The attributes are left out of
+// badVerb and badArgument responses.
+type Request struct {
+	BaseURL string `xml:",chardata"`
+
+	Verb string `xml:"verb,attr,omitempty"`
+
+	Identifier string `xml:"identifier,attr,omitempty"`
+
+	MetadataPrefix string `xml:"metadataPrefix,attr,omitempty"`
+
+	From string `xml:"from,attr,omitempty"`
+
+	Until string `xml:"until,attr,omitempty"`
+
+	Set string `xml:"set,attr,omitempty"`
+
+	ResumptionToken string `xml:"resumptionToken,attr,omitempty"`
+}
+
+// Information about a repository.
+type Identify struct {
+	RepositoryName string `xml:"repositoryName"`
+
+	BaseURL string `xml:"baseURL"`
+
+	ProtocolVersion string `xml:"protocolVersion"`
+
+	AdminEmails []string `xml:"adminEmail"`
+
+	EarliestDatestamp string `xml:"earliestDatestamp"`
+
+	// One of DeletedNo, DeletedTransient and DeletedPersistent.
+	DeletedRecord string `xml:"deletedRecord"`
+
+	// GranularityDay or GranularitySeconds.
+	Granularity string `xml:"granularity"`
+}
+
+// Describes a metadata format of a repository.
+type MetadataFormat struct {
+	MetadataPrefix string `xml:"metadataPrefix"`
+
+	// The URL of the XML schema of the format.
+	Schema string `xml:"schema"`
+
+	MetadataNamespace string `xml:"metadataNamespace"`
+}
+
+type ListMetadataFormats struct {
+	MetadataFormats []*MetadataFormat `xml:"metadataFormat"`
+}
+
+type ListIdentifiers struct {
+	Headers []*Header `xml:"header"`
+
+	ResumptionToken *ResumptionToken `xml:"resumptionToken"`
+}
+
+type ListRecords struct {
+	Records []*Record `xml:"record"`
+
+	ResumptionToken *ResumptionToken `xml:"resumptionToken"`
+}
+
+type GetRecord struct {
+	Record *Record `xml:"record"`
+}
+
+// Continues an incomplete list. The token is empty in the last part of the
+// list.
+type ResumptionToken struct {
+	Token string `xml:",chardata"`
+
+	CompleteListSize int `xml:"completeListSize,attr,omitempty"`
+
+	Cursor int `xml:"cursor,attr"`
+}
+
+// The unique identifier, datestamp and status of an item.
+type Header struct {
+	// "deleted" for deleted items, otherwise empty.
+	Status string `xml:"status,attr,omitempty"`
+
+	Identifier string `xml:"identifier"`
+
+	Datestamp string `xml:"datestamp"`
+
+	SetSpecs []string `xml:"setSpec"`
+}
+
+// Reports whether the item has been deleted.
+func (h *Header) Deleted() bool {
+	return h.Status == "deleted"
+}
+
+// Returns the parsed datestamp.
+func (h *Header) Time() (time.Time, error) {
+	t, _, err := ParseDatestamp(h.Datestamp)
+	return t, err
+}
+
+// The metadata of an item in one format.
+type Record struct {
+	Header *Header `xml:"header"`
+
+	// Nil for deleted items.
+	Metadata *Metadata `xml:"metadata"`
+}
+
+// Holds the XML of a record, e.g. a lido element. The namespaces it uses
+// must be declared in the XML itself, as OAI-PMH requires.
+type Metadata struct {
+	XML []byte `xml:",innerxml"`
+}
+
+// Decodes the metadata with the Unmarshal function of the specified format.
+func (m *Metadata) Decode(f *Format) (interface{}, error) {
+	return f.Unmarshal(m.XML)
+}
@@ -0,0 +1,231 @@
+package oaipmh
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/verisart/xsd/lido"
+	"github.com/verisart/xsd/mets"
+)
+
+func newTestServer(t *testing.T) (*httptest.Server, *Client) {
+	store := NewMemoryStore()
+	base := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		l := &lido.Lido{}
+		l.AppendRecID("test", lido.LocalRecordType, fmt.Sprintf("rec-%d", i))
+		l.CreateDesc("en").ObjectID.TitleWrap.Append(lido.NewTitle(fmt.Sprintf("Work %d", i), "en", true, ""))
+		store.Put("lido", &Item{
+			Identifier: fmt.Sprintf("oai:test:%d", i),
+			Datestamp:  base.AddDate(0, 0, i),
+			Metadata:   l,
+		})
+	}
+	store.Put("lido", &Item{Identifier: "oai:test:gone", Datestamp: base.AddDate(0, 0, 10), Deleted: true})
+	store.Put("mets", &Item{Identifier: "oai:test:0", Datestamp: base, Metadata: &mets.Mets{ObjID: "obj-0"}})
+
+	s := &Server{
+		Store:    store,
+		Formats:  []*Format{LidoFormat, MetsFormat, DCFormat},
+		Identify: Identify{RepositoryName: "Test", AdminEmails: []string{"admin@example.org"}},
+		PageSize: 2,
+		Now:      func() time.Time { return base },
+	}
+	ts := httptest.NewServer(s)
+	s.Identify.BaseURL = ts.URL
+	return ts, NewClient(ts.URL)
+}
+
+func TestHarvest(t *testing.T) {
+	ts, c := newTestServer(t)
+	defer ts.Close()
+
+	id, err := c.Identify()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.RepositoryName != "Test" || id.ProtocolVersion != "2.0" || id.EarliestDatestamp != "2024-03-01T12:00:00Z" {
+		t.Errorf("unexpected Identify: %+v", id)
+	}
+
+	formats, err := c.ListMetadataFormats("")
+	if err != nil || len(formats) != 3 || formats[0].MetadataPrefix != "lido" {
+		t.Errorf("ListMetadataFormats: %v, %v", formats, err)
+	}
+	if formats, err = c.ListMetadataFormats("oai:test:3"); err != nil || len(formats) != 2 || formats[1].MetadataPrefix != "oai_dc" {
+		t.Errorf("ListMetadataFormats(oai:test:3): %v, %v", formats, err)
+	}
+
+	rec, err := c.GetRecord("oai:test:2", "lido")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := rec.Metadata.Lido()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(l.LidoRecIDs[0].Value); got != "rec-2" {
+		t.Errorf("GetRecord: got %q, want rec-2", got)
+	}
+	rec, err = c.GetRecord("oai:test:0", "mets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m, err := rec.Metadata.Mets(); err != nil || m.ObjID != "obj-0" {
+		t.Errorf("GetRecord(mets): %v, %v", m, err)
+	}
+	rec, err = c.GetRecord("oai:test:0", "oai_dc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, err := rec.Metadata.DC(); err != nil || len(r.Titles) != 1 || r.Titles[0].Value != "Work 0" {
+		t.Errorf("GetRecord(oai_dc): %+v, %v", r, err)
+	}
+	if _, err := c.GetRecord("oai:test:1", "mets"); err == nil || err.(*Error).Code != CannotDisseminateFormat {
+		t.Errorf("GetRecord in missing format: %v", err)
+	}
+	if _, err := c.GetRecord("oai:test:99", "lido"); err == nil || err.(*Error).Code != IdDoesNotExist {
+		t.Errorf("GetRecord of missing item: %v", err)
+	}
+
+	var ids []string
+	deleted := 0
+	err = c.ListRecords("lido", time.Time{}, time.Time{}, func(rec *Record) error {
+		ids = append(ids, rec.Header.Identifier)
+		if rec.Header.Deleted() {
+			deleted++
+			if rec.Metadata != nil {
+				t.Errorf("deleted record %s has metadata", rec.Header.Identifier)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 6 || deleted != 1 {
+		t.Errorf("ListRecords: got %v (%d deleted)", ids, deleted)
+	}
+
+	ids = nil
+	err = c.ListIdentifiers("oai_dc", time.Time{}, time.Time{}, func(h *Header) error {
+		ids = append(ids, h.Identifier)
+		return nil
+	})
+	if err != nil || len(ids) != 6 {
+		t.Errorf("ListIdentifiers(oai_dc): %v, %v", ids, err)
+	}
+
+	ids = nil
+	from := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)
+	err = c.ListIdentifiers("lido", from, until, func(h *Header) error {
+		ids = append(ids, h.Identifier)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(ids, " ") != "oai:test:1 oai:test:2 oai:test:3" {
+		t.Errorf("ListIdentifiers from %v until %v: got %v", from, until, ids)
+	}
+
+	c.Granularity = GranularityDay
+	ids = nil
+	err = c.ListIdentifiers("lido", until, until, func(h *Header) error {
+		ids = append(ids, h.Identifier)
+		return nil
+	})
+	if err != nil || len(ids) != 1 {
+		t.Errorf("ListIdentifiers for one day: %v, %v", ids, err)
+	}
+
+	called := false
+	err = c.ListRecords("lido", time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}, func(*Record) error {
+		called = true
+		return nil
+	})
+	if err != nil || called {
+		t.Errorf("noRecordsMatch: called %v, err %v", called, err)
+	}
+}
+
+func TestProtocolErrors(t *testing.T) {
+	ts, _ := newTestServer(t)
+	defer ts.Close()
+
+	tests := []struct {
+		query string
+		code  string
+	}{
+		{"", BadVerb},
+		{"verb=Frobnicate", BadVerb},
+		{"verb=Identify&verb=Identify", BadVerb},
+		{"verb=Identify&identifier=x", BadArgument},
+		{"verb=ListRecords", BadArgument},
+		{"verb=ListRecords&metadataPrefix=lido&metadataPrefix=mets", BadArgument},
+		{"verb=ListRecords&metadataPrefix=lido&resumptionToken=abc", BadArgument},
+		{"verb=ListRecords&resumptionToken=!!!", BadResumptionToken},
+		{"verb=ListRecords&metadataPrefix=lido&from=2024-03-01&until=2024-03-02T00:00:00Z", BadArgument},
+		{"verb=ListRecords&metadataPrefix=lido&from=yesterday", BadArgument},
+		{"verb=ListRecords&metadataPrefix=dc", CannotDisseminateFormat},
+		{"verb=ListIdentifiers&metadataPrefix=lido&set=paintings", NoSetHierarchy},
+		{"verb=ListSets", NoSetHierarchy},
+		{"verb=ListMetadataFormats&identifier=nope", IdDoesNotExist},
+		{"verb=GetRecord&identifier=oai:test:1", BadArgument},
+	}
+	for _, test := range tests {
+		resp, err := http.Get(ts.URL + "?" + test.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if want := `<error code="` + test.code + `">`; !strings.Contains(string(body), want) {
+			t.Errorf("%s: want %s in %s", test.query, want, body)
+		}
+		if (test.code == BadVerb || test.code == BadArgument) && strings.Contains(string(body), `<request verb=`) {
+			t.Errorf("%s: request element has attributes: %s", test.query, body)
+		}
+	}
+
+	resp, err := http.PostForm(ts.URL, map[string][]string{"verb": {"Identify"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(body), `<request verb="Identify">`+ts.URL+`</request>`) {
+		t.Errorf("POST Identify: %s", body)
+	}
+}
+
+func TestIdentifyRequired(t *testing.T) {
+	s := &Server{Store: NewMemoryStore(), Identify: Identify{RepositoryName: "Test", BaseURL: "http://example.org/oai"}}
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/?verb=Identify", nil))
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("Identify without AdminEmails: status %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestMemoryStoreUntil(t *testing.T) {
+	store := NewMemoryStore()
+	stamp := time.Date(2024, 3, 1, 23, 59, 59, 500000000, time.UTC)
+	store.Put("lido", &Item{Identifier: "oai:test:late", Datestamp: stamp})
+
+	for _, until := range []string{"2024-03-01T23:59:59Z", "2024-03-01"} {
+		_, u, err := parseRange("", until)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if items, total, err := store.List("lido", time.Time{}, u, 0, 10); err != nil || total != 1 || len(items) != 1 {
+			t.Errorf("until %s: got %d items, %v", until, total, err)
+		}
+	}
+}
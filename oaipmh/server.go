@@ -0,0 +1,391 @@
+package oaipmh
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// The default number of items in each part of an incomplete list.
+const DefaultPageSize = 100
+
+// An http.Handler answering OAI-PMH requests from a Store. Both GET and POST
+// requests are accepted. Lists longer than PageSize are split into parts
+// linked by resumption tokens; the tokens hold the position in the list, so
+// no state is kept between requests.
+type Server struct {
+	Store Store
+
+	// The formats the repository disseminates, e.g. LidoFormat and
+	// MetsFormat.
+	Formats []*Format
+
+	// Information about the repository. RepositoryName, BaseURL and at least
+	// one admin email are required; Identify requests fail without them. The
+	// protocol version, earliest datestamp and granularity are filled in by
+	// the server, and the deleted record policy defaults to DeletedNo.
+	Identify Identify
+
+	// The number of items in each part of a list; DefaultPageSize if zero.
+	PageSize int
+
+	// Returns the current time; time.Now if nil.
+	Now func() time.Time
+}
+
+// The arguments each verb allows, and whether they are required. The
+// resumptionToken argument is exclusive.
+var verbArguments = map[string]map[string]bool{
+	VerbIdentify:            {},
+	VerbListMetadataFormats: {"identifier": false},
+	VerbListSets:            {"resumptionToken": false},
+	VerbListIdentifiers:     {"metadataPrefix": true, "from": false, "until": false, "set": false, "resumptionToken": false},
+	VerbListRecords:         {"metadataPrefix": true, "from": false, "until": false, "set": false, "resumptionToken": false},
+	VerbGetRecord:           {"identifier": true, "metadataPrefix": true},
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now
+	if s.Now != nil {
+		now = s.Now
+	}
+	resp := &Response{
+		ResponseDate: FormatDatestamp(now(), GranularitySeconds),
+		Request:      Request{BaseURL: s.Identify.BaseURL},
+	}
+
+	if err := s.answer(resp, r.Form); err != nil {
+		if e, ok := err.(*Error); ok {
+			resp.Errors = append(resp.Errors, e)
+			if e.Code == BadVerb || e.Code == BadArgument {
+				resp.Request = Request{BaseURL: s.Identify.BaseURL}
+			}
+		} else {
+			log.Printf("oaipmh: %v", err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	data, err := xml.Marshal(resp)
+	if err != nil {
+		log.Printf("oaipmh: %v", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	w.Write([]byte(xml.Header))
+	w.Write(data)
+}
+
+func badArgument(msg string) *Error {
+	return &Error{Code: BadArgument, Message: msg}
+}
+
+// Checks the arguments against the verb, fills in the echoed request and
+// dispatches to the verb.
+func (s *Server) answer(resp *Response, form url.Values) error {
+	verbs := form["verb"]
+	if len(verbs) != 1 {
+		return &Error{Code: BadVerb, Message: "The verb argument must be given exactly once"}
+	}
+	verb := verbs[0]
+	allowed, ok := verbArguments[verb]
+	if !ok {
+		return &Error{Code: BadVerb, Message: "Illegal verb " + strconv.Quote(verb)}
+	}
+	args := map[string]string{}
+	for name, values := range form {
+		if name == "verb" {
+			continue
+		}
+		if _, ok := allowed[name]; !ok {
+			return badArgument("Illegal argument " + strconv.Quote(name))
+		}
+		if len(values) != 1 {
+			return badArgument("Repeated argument " + strconv.Quote(name))
+		}
+		args[name] = values[0]
+	}
+	if token, ok := args["resumptionToken"]; ok {
+		if len(args) != 1 {
+			return badArgument("The resumptionToken argument is exclusive")
+		}
+		if token == "" {
+			return badArgument("Empty resumptionToken")
+		}
+	} else {
+		for name, required := range allowed {
+			if _, ok := args[name]; required && !ok {
+				return badArgument("Missing required argument " + strconv.Quote(name))
+			}
+		}
+	}
+
+	resp.Request = Request{
+		BaseURL:         s.Identify.BaseURL,
+		Verb:            verb,
+		Identifier:      args["identifier"],
+		MetadataPrefix:  args["metadataPrefix"],
+		From:            args["from"],
+		Until:           args["until"],
+		Set:             args["set"],
+		ResumptionToken: args["resumptionToken"],
+	}
+
+	switch verb {
+	case VerbIdentify:
+		return s.identify(resp)
+	case VerbListMetadataFormats:
+		return s.listMetadataFormats(resp, args["identifier"])
+	case VerbGetRecord:
+		return s.getRecord(resp, args["identifier"], args["metadataPrefix"])
+	case VerbListSets:
+		return &Error{Code: NoSetHierarchy, Message: "This repository does not support sets"}
+	}
+	return s.list(resp, verb, args)
+}
+
+func (s *Server) identify(resp *Response) error {
+	id := s.Identify
+	switch {
+	case id.RepositoryName == "":
+		return errors.New("oaipmh: Identify has no RepositoryName")
+	case id.BaseURL == "":
+		return errors.New("oaipmh: Identify has no BaseURL")
+	case len(id.AdminEmails) == 0:
+		return errors.New("oaipmh: Identify has no AdminEmails")
+	}
+	id.ProtocolVersion = ProtocolVersion
+	id.Granularity = GranularitySeconds
+	if id.DeletedRecord == "" {
+		id.DeletedRecord = DeletedNo
+	}
+	earliest, err := s.Store.Earliest()
+	if err != nil {
+		return err
+	}
+	id.EarliestDatestamp = FormatDatestamp(earliest, GranularitySeconds)
+	resp.Identify = &id
+	return nil
+}
+
+func (s *Server) format(prefix string) *Format {
+	for _, f := range s.Formats {
+		if f.MetadataPrefix == prefix {
+			return f
+		}
+	}
+	return nil
+}
+
+func (s *Server) listMetadataFormats(resp *Response, identifier string) error {
+	list := &ListMetadataFormats{}
+	if identifier == "" {
+		for _, f := range s.Formats {
+			format := f.MetadataFormat
+			list.MetadataFormats = append(list.MetadataFormats, &format)
+		}
+	} else {
+		prefixes, err := s.Store.Formats(identifier)
+		if err != nil {
+			return err
+		}
+		for _, f := range s.Formats {
+			for _, prefix := range prefixes {
+				if f.source() == prefix {
+					format := f.MetadataFormat
+					list.MetadataFormats = append(list.MetadataFormats, &format)
+					break
+				}
+			}
+		}
+	}
+	if len(list.MetadataFormats) == 0 {
+		return ErrNoMetadataFormats
+	}
+	resp.ListMetadataFormats = list
+	return nil
+}
+
+// Returns the header and, unless headerOnly is set or the item is deleted,
+// the metadata of an item.
+func (s *Server) record(f *Format, item *Item, headerOnly bool) (*Record, error) {
+	rec := &Record{Header: &Header{
+		Identifier: item.Identifier,
+		Datestamp:  FormatDatestamp(item.Datestamp, GranularitySeconds),
+	}}
+	if item.Deleted {
+		rec.Header.Status = "deleted"
+		return rec, nil
+	}
+	if headerOnly {
+		return rec, nil
+	}
+	data, err := f.Marshal(item.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	rec.Metadata = &Metadata{XML: data}
+	return rec, nil
+}
+
+func (s *Server) getRecord(resp *Response, identifier, prefix string) error {
+	f := s.format(prefix)
+	if f == nil {
+		return ErrCannotDisseminateFormat
+	}
+	item, err := s.Store.Get(identifier, f.source())
+	if err != nil {
+		return err
+	}
+	rec, err := s.record(f, item, false)
+	if err != nil {
+		return err
+	}
+	resp.GetRecord = &GetRecord{Record: rec}
+	return nil
+}
+
+// The position in a list, as held by a resumption token.
+type listState struct {
+	prefix, from, until string
+	offset              int
+}
+
+func (st *listState) token() string {
+	v := url.Values{}
+	v.Set("m", st.prefix)
+	v.Set("o", strconv.Itoa(st.offset))
+	if st.from != "" {
+		v.Set("f", st.from)
+	}
+	if st.until != "" {
+		v.Set("u", st.until)
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(v.Encode()))
+}
+
+func parseToken(token string) (*listState, error) {
+	bad := &Error{Code: BadResumptionToken, Message: "Invalid resumption token"}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, bad
+	}
+	v, err := url.ParseQuery(string(data))
+	if err != nil {
+		return nil, bad
+	}
+	st := &listState{prefix: v.Get("m"), from: v.Get("f"), until: v.Get("u")}
+	if st.offset, err = strconv.Atoi(v.Get("o")); err != nil || st.offset < 0 || st.prefix == "" {
+		return nil, bad
+	}
+	return st, nil
+}
+
+// Parses the from and until arguments. A until date of day granularity
+// includes the whole day.
+func parseRange(from, until string) (f, u time.Time, err error) {
+	var fromDay, untilDay bool
+	if from != "" {
+		if f, fromDay, err = ParseDatestamp(from); err != nil {
+			return f, u, badArgument("Invalid from datestamp " + strconv.Quote(from))
+		}
+	}
+	if until != "" {
+		if u, untilDay, err = ParseDatestamp(until); err != nil {
+			return f, u, badArgument("Invalid until datestamp " + strconv.Quote(until))
+		}
+		if untilDay {
+			u = u.Add(24*time.Hour - time.Second)
+		}
+	}
+	if from != "" && until != "" {
+		if fromDay != untilDay {
+			return f, u, badArgument("The from and until arguments have different granularities")
+		}
+		if f.After(u) {
+			return f, u, badArgument("The from argument is later than the until argument")
+		}
+	}
+	return f, u, nil
+}
+
+func (s *Server) list(resp *Response, verb string, args map[string]string) error {
+	var st *listState
+	if token, ok := args["resumptionToken"]; ok {
+		var err error
+		if st, err = parseToken(token); err != nil {
+			return err
+		}
+	} else {
+		if _, ok := args["set"]; ok {
+			return &Error{Code: NoSetHierarchy, Message: "This repository does not support sets"}
+		}
+		st = &listState{prefix: args["metadataPrefix"], from: args["from"], until: args["until"]}
+	}
+
+	f := s.format(st.prefix)
+	if f == nil {
+		return ErrCannotDisseminateFormat
+	}
+	from, until, err := parseRange(st.from, st.until)
+	if err != nil {
+		return err
+	}
+	pageSize := s.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	items, total, err := s.Store.List(f.source(), from, until, st.offset, pageSize)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		if st.offset > 0 {
+			return &Error{Code: BadResumptionToken, Message: "The resumption token is past the end of the list"}
+		}
+		return &Error{Code: NoRecordsMatch, Message: "No items match the arguments"}
+	}
+
+	var token *ResumptionToken
+	if st.offset > 0 || len(items) < total {
+		token = &ResumptionToken{CompleteListSize: total, Cursor: st.offset}
+		if next := st.offset + len(items); next < total {
+			token.Token = (&listState{prefix: st.prefix, from: st.from, until: st.until, offset: next}).token()
+		}
+	}
+
+	headerOnly := verb == VerbListIdentifiers
+	records := make([]*Record, len(items))
+	for i, item := range items {
+		if records[i], err = s.record(f, item, headerOnly); err != nil {
+			return err
+		}
+	}
+	if headerOnly {
+		list := &ListIdentifiers{ResumptionToken: token}
+		for _, rec := range records {
+			list.Headers = append(list.Headers, rec.Header)
+		}
+		resp.ListIdentifiers = list
+	} else {
+		resp.ListRecords = &ListRecords{Records: records, ResumptionToken: token}
+	}
+	return nil
+}
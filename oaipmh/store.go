@@ -0,0 +1,148 @@
+package oaipmh
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// An item of a repository in one metadata format.
+type Item struct {
+	Identifier string
+
+	// The time the item was created, changed or deleted.
+	Datestamp time.Time
+
+	Deleted bool
+
+	// The record, e.g. a *lido.Lido, or nil if the item is deleted.
+	Metadata interface{}
+}
+
+// The records a Server disseminates. Implementations must be safe for
+// concurrent use.
+type Store interface {
+	// Returns the item with the specified identifier in the format with the
+	// specified prefix, or ErrIdDoesNotExist or ErrCannotDisseminateFormat.
+	Get(identifier, prefix string) (*Item, error)
+
+	// Returns at most limit items in the format with the specified prefix,
+	// starting at offset, and the total number of items. Only items whose
+	// datestamps, truncated to the second as they are shown to harvesters,
+	// lie between from and until (inclusive) are counted; a zero time leaves
+	// that end of the range open. Items must be listed in a stable order, so
+	// that a list can be resumed at an offset.
+	List(prefix string, from, until time.Time, offset, limit int) (items []*Item, total int, err error)
+
+	// Returns the prefixes of the formats the identified item is available
+	// in, or ErrIdDoesNotExist.
+	Formats(identifier string) ([]string, error)
+
+	// Returns the datestamp of the oldest item, or the zero time if there
+	// are none.
+	Earliest() (time.Time, error)
+}
+
+// A Store holding its items in memory, for tests and small repositories.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	items map[string][]*Item // by prefix, ordered by datestamp and identifier
+}
+
+// Returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{items: map[string][]*Item{}}
+}
+
+// Adds or replaces the item with the identifier of item in the format with
+// the specified prefix.
+func (s *MemoryStore) Put(prefix string, item *Item) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items := s.items[prefix]
+	for i, it := range items {
+		if it.Identifier == item.Identifier {
+			items = append(items[:i], items[i+1:]...)
+			break
+		}
+	}
+	items = append(items, item)
+	sort.SliceStable(items, func(i, j int) bool {
+		if !items[i].Datestamp.Equal(items[j].Datestamp) {
+			return items[i].Datestamp.Before(items[j].Datestamp)
+		}
+		return items[i].Identifier < items[j].Identifier
+	})
+	s.items[prefix] = items
+}
+
+func (s *MemoryStore) Get(identifier, prefix string) (*Item, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	found := false
+	for p, items := range s.items {
+		for _, item := range items {
+			if item.Identifier == identifier {
+				if p == prefix {
+					return item, nil
+				}
+				found = true
+			}
+		}
+	}
+	if found {
+		return nil, ErrCannotDisseminateFormat
+	}
+	return nil, ErrIdDoesNotExist
+}
+
+func (s *MemoryStore) List(prefix string, from, until time.Time, offset, limit int) ([]*Item, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var matches []*Item
+	for _, item := range s.items[prefix] {
+		stamp := item.Datestamp.Truncate(time.Second)
+		if (from.IsZero() || !stamp.Before(from)) && (until.IsZero() || !stamp.After(until)) {
+			matches = append(matches, item)
+		}
+	}
+	total := len(matches)
+	if offset > total {
+		offset = total
+	}
+	if offset+limit < total {
+		matches = matches[:offset+limit]
+	}
+	return matches[offset:], total, nil
+}
+
+func (s *MemoryStore) Formats(identifier string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var prefixes []string
+	for p, items := range s.items {
+		for _, item := range items {
+			if item.Identifier == identifier {
+				prefixes = append(prefixes, p)
+				break
+			}
+		}
+	}
+	if prefixes == nil {
+		return nil, ErrIdDoesNotExist
+	}
+	sort.Strings(prefixes)
+	return prefixes, nil
+}
+
+func (s *MemoryStore) Earliest() (time.Time, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var earliest time.Time
+	for _, items := range s.items {
+		if len(items) > 0 && (earliest.IsZero() || items[0].Datestamp.Before(earliest)) {
+			earliest = items[0].Datestamp
+		}
+	}
+	return earliest, nil
+}
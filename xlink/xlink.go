@@ -5,23 +5,32 @@ import (
 )
 
 type XLinkHrefAttr struct {
-	Href xsdt.AnyURI `xml:"http://www.w3.org/1999/xlink href,attr,omitempty"`
+	Href xsdt.AnyURI `xml:"http://www.w3.org/1999/xlink href,attr,omitempty" json:"href,omitempty"`
 }
 
 type XLinkRoleAttr struct {
-	Role xsdt.String `xml:"http://www.w3.org/1999/xlink role,attr,omitempty"`
+	Role xsdt.String `xml:"http://www.w3.org/1999/xlink role,attr,omitempty" json:"role,omitempty"`
 }
 
 type XLinkArcRoleAttr struct {
-	Arcrole xsdt.String `xml:"http://www.w3.org/1999/xlink arcrole,attr,omitempty"`
+	Arcrole xsdt.String `xml:"http://www.w3.org/1999/xlink arcrole,attr,omitempty" json:"arcrole,omitempty"`
 }
 
 type XLinkTitleAttr struct {
-	Title xsdt.String `xml:"http://www.w3.org/1999/xlink title,attr,omitempty"`
+	Title xsdt.String `xml:"http://www.w3.org/1999/xlink title,attr,omitempty" json:"title,omitempty"`
 }
 
 type XLinkShowType xsdt.String
 
+//	The xlink:show enumeration, as a restriction of xs:string.
+var XLinkShowRestriction = xsdt.MustRestriction("xlink:show", "string", xsdt.Enumeration("new", "replace", "embed", "other", "none"))
+
+//	Checks the current value against XLinkShowRestriction.
+func (me XLinkShowType) Validate() error { return XLinkShowRestriction.Validate(string(me)) }
+
+//	Returns true if the current value is one of the enumerated values of TxsdShow.
+func (me XLinkShowType) IsValid() bool { return me.Validate() == nil }
+
 //	Since TxsdShow is just a simple String type, this merely returns the current string value.
 func (me XLinkShowType) String() string { return xsdt.String(me).String() }
 
@@ -47,11 +56,20 @@ func (me XLinkShowType) IsNone() bool { return me.String() == "none" }
 func (me *XLinkShowType) Set(s string) { (*xsdt.String)(me).Set(s) }
 
 type XLinkShowAttr struct {
-	Show XLinkShowType `xml:"http://www.w3.org/1999/xlink show,attr,omitempty"`
+	Show XLinkShowType `xml:"http://www.w3.org/1999/xlink show,attr,omitempty" json:"show,omitempty"`
 }
 
 type XLinkActuateType xsdt.String
 
+//	The xlink:actuate enumeration, as a restriction of xs:string.
+var XLinkActuateRestriction = xsdt.MustRestriction("xlink:actuate", "string", xsdt.Enumeration("onLoad", "onRequest", "other", "none"))
+
+//	Checks the current value against XLinkActuateRestriction.
+func (me XLinkActuateType) Validate() error { return XLinkActuateRestriction.Validate(string(me)) }
+
+//	Returns true if the current value is one of the enumerated values of TxsdActuate.
+func (me XLinkActuateType) IsValid() bool { return me.Validate() == nil }
+
 //	This convenience method just performs a simple type conversion to TxsdActuate's alias type xsdt.String.
 func (me XLinkActuateType) ToXsdtString() xsdt.String { return xsdt.String(me) }
 
@@ -74,23 +92,23 @@ func (me *XLinkActuateType) Set(s string) { (*xsdt.String)(me).Set(s) }
 func (me XLinkActuateType) String() string { return xsdt.String(me).String() }
 
 type XLinkActuateAttr struct {
-	Actuate XLinkActuateType `xml:"http://www.w3.org/1999/xlink actuate,attr,omitempty"`
+	Actuate XLinkActuateType `xml:"http://www.w3.org/1999/xlink actuate,attr,omitempty" json:"actuate,omitempty"`
 }
 
 type XLinkLabelAttr struct {
-	Label xsdt.String `xml:"http://www.w3.org/1999/xlink label,attr,omitempty"`
+	Label xsdt.String `xml:"http://www.w3.org/1999/xlink label,attr,omitempty" json:"label,omitempty"`
 }
 
 type XLinkFromAttr struct {
-	From xsdt.String `xml:"http://www.w3.org/1999/xlink from,attr,omitempty"`
+	From xsdt.String `xml:"http://www.w3.org/1999/xlink from,attr,omitempty" json:"from,omitempty"`
 }
 
 type XLinkToAttr struct {
-	To xsdt.String `xml:"http://www.w3.org/1999/xlink to,attr,omitempty"`
+	To xsdt.String `xml:"http://www.w3.org/1999/xlink to,attr,omitempty" json:"to,omitempty"`
 }
 
 type XLinkTypeAttr struct {
-	Type xsdt.String `xml:"http://www.w3.org/1999/xlink type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.w3.org/1999/xlink type,attr,omitempty" json:"type,omitempty"`
 }
 
 //	Returns the fixed value for Type -- "simple"
@@ -115,7 +133,7 @@ type SimpleLink struct {
 }
 
 type XLinkTypeExtendedAttr struct {
-	Type xsdt.String `xml:"http://www.w3.org/1999/xlink type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.w3.org/1999/xlink type,attr,omitempty" json:"type,omitempty"`
 }
 
 //	Returns the fixed value for Type -- "extended"
@@ -132,7 +150,7 @@ type ExtendedLink struct {
 }
 
 type XLinkTypeLocatorAttr struct {
-	Type xsdt.String `xml:"http://www.w3.org/1999/xlink type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.w3.org/1999/xlink type,attr,omitempty" json:"type,omitempty"`
 }
 
 //	Returns the fixed value for Type -- "locator"
@@ -153,7 +171,7 @@ type LocatorLink struct {
 }
 
 type XLinkTypeArcAttr struct {
-	Type xsdt.String `xml:"http://www.w3.org/1999/xlink type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.w3.org/1999/xlink type,attr,omitempty" json:"type,omitempty"`
 }
 
 //	Returns the fixed value for Type -- "arc"
@@ -176,7 +194,7 @@ type ArcLink struct {
 }
 
 type XLinkTypeResourceAttr struct {
-	Type xsdt.String `xml:"http://www.w3.org/1999/xlink type,attr"`
+	Type xsdt.String `xml:"http://www.w3.org/1999/xlink type,attr" json:"type,omitempty"`
 }
 
 //	Returns the fixed value for Type -- "resource"
@@ -195,7 +213,7 @@ type ResourceLink struct {
 }
 
 type XLinkTypeTitleAttr struct {
-	Type xsdt.String `xml:"http://www.w3.org/1999/xlink type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.w3.org/1999/xlink type,attr,omitempty" json:"type,omitempty"`
 }
 
 //	Returns the fixed value for Type -- "title"
@@ -206,7 +224,7 @@ type TitleLink struct {
 }
 
 type XLinkTypeNoneAttr struct {
-	Type xsdt.String `xml:"http://www.w3.org/1999/xlink type,attr,omitempty"`
+	Type xsdt.String `xml:"http://www.w3.org/1999/xlink type,attr,omitempty" json:"type,omitempty"`
 }
 
 //	Returns the fixed value for Type -- "none"
@@ -0,0 +1,221 @@
+package xsdt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"io"
+)
+
+//	The number of raw bytes EncodeBase64Element and EncodeHexElement read per character data token.
+const binaryChunkSize = 3 * 4096
+
+//	Removes all XSD whitespace from b, in place.
+func stripXsdWhitespace(b []byte) []byte {
+	n := 0
+	for _, c := range b {
+		if !isXsdWhitespace(rune(c)) {
+			b[n] = c
+			n++
+		}
+	}
+	return b[:n]
+}
+
+//	An io.Reader that drops XSD whitespace from the underlying reader.
+type whitespaceFilter struct {
+	r io.Reader
+}
+
+func (me *whitespaceFilter) Read(p []byte) (n int, err error) {
+	for n == 0 && err == nil {
+		n, err = me.r.Read(p)
+		n = len(stripXsdWhitespace(p[:n]))
+	}
+	return
+}
+
+//	An io.Reader over the character data of the current element of an xml.Decoder, stopping at its end tag.
+type charDataReader struct {
+	d    *xml.Decoder
+	buf  []byte
+	done bool
+}
+
+func (me *charDataReader) Read(p []byte) (int, error) {
+	for len(me.buf) == 0 {
+		if me.done {
+			return 0, io.EOF
+		}
+		tok, err := me.d.Token()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		switch t := tok.(type) {
+		case xml.CharData:
+			me.buf = append(me.buf[:0], t...)
+		case xml.EndElement:
+			me.done = true
+		case xml.StartElement:
+			return 0, errors.New("xsdt: unexpected element <" + t.Name.Local + "> in binary content")
+		}
+	}
+	n := copy(p, me.buf)
+	me.buf = me.buf[n:]
+	return n, nil
+}
+
+//	Writes start, the character data produced by encode from everything read from r, and the matching end tag.
+func encodeBinaryElement(e *xml.Encoder, start xml.StartElement, r io.Reader, encode func([]byte) []byte) (total int64, err error) {
+	if err = e.EncodeToken(start); err != nil {
+		return
+	}
+	chunk := make([]byte, binaryChunkSize)
+	for {
+		n, rerr := io.ReadFull(r, chunk)
+		if n > 0 {
+			total += int64(n)
+			if err = e.EncodeToken(xml.CharData(encode(chunk[:n]))); err != nil {
+				return
+			}
+		}
+		if rerr == io.EOF || rerr == io.ErrUnexpectedEOF {
+			break
+		} else if rerr != nil {
+			return total, rerr
+		}
+	}
+	if err = e.EncodeToken(start.End()); err == nil {
+		err = e.Flush()
+	}
+	return
+}
+
+//	Returns the Base64Binary holding the canonical encoding of b.
+func Base64BinaryOf(b []byte) Base64Binary {
+	return Base64Binary(base64.StdEncoding.EncodeToString(b))
+}
+
+//	Decodes the current value. Whitespace anywhere in the value is ignored, as XSD permits.
+func (me Base64Binary) Bytes() ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(string(stripXsdWhitespace([]byte(me))))
+	if err != nil {
+		return nil, &ParseError{Type: "base64Binary", Value: string(me), Err: ErrSyntax}
+	}
+	return b, nil
+}
+
+//	Sets the current value to the canonical encoding of b.
+func (me *Base64Binary) SetBytes(b []byte) {
+	*me = Base64BinaryOf(b)
+}
+
+//	Like Set, but returns an error if the specified string is not valid base64, in which case the current value is
+//	left unchanged. The stored value has all whitespace removed.
+func (me *Base64Binary) Parse(s string) error {
+	v := Base64Binary(stripXsdWhitespace([]byte(s)))
+	if _, err := base64.StdEncoding.DecodeString(string(v)); err != nil {
+		return &ParseError{Type: "base64Binary", Value: s, Err: ErrSyntax}
+	}
+	*me = v
+	return nil
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Base64Binary) MarshalText() ([]byte, error) {
+	return []byte(me), nil
+}
+
+//	Implements encoding.TextUnmarshaler, validating the value as Parse does.
+func (me *Base64Binary) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Returns a reader that decodes the base64 text read from r, ignoring any whitespace in it.
+func NewBase64Reader(r io.Reader) io.Reader {
+	return base64.NewDecoder(base64.StdEncoding, &whitespaceFilter{r})
+}
+
+//	Returns a writer that base64-encodes everything written to it into w. Close must be called to flush the final partial block.
+func NewBase64Writer(w io.Writer) io.WriteCloser {
+	return base64.NewEncoder(base64.StdEncoding, w)
+}
+
+//	Decodes the base64 content of the element whose start tag was just read from d into w, without ever holding
+//	the whole payload in memory, and consumes the element's end tag. Meant for use from an UnmarshalXML method or a
+//	token loop, e.g. for METS binData, xmlenc CipherValue or dsig X509Certificate elements.
+func DecodeBase64Element(d *xml.Decoder, w io.Writer) (int64, error) {
+	return io.Copy(w, NewBase64Reader(&charDataReader{d: d}))
+}
+
+//	Writes an element with the given start tag whose content is the base64 encoding of everything read from r.
+func EncodeBase64Element(e *xml.Encoder, start xml.StartElement, r io.Reader) (int64, error) {
+	return encodeBinaryElement(e, start, r, func(b []byte) []byte {
+		out := make([]byte, base64.StdEncoding.EncodedLen(len(b)))
+		base64.StdEncoding.Encode(out, b)
+		return out
+	})
+}
+
+//	Returns the HexBinary holding the canonical (upper-case) encoding of b.
+func HexBinaryOf(b []byte) HexBinary {
+	return HexBinary(bytes.ToUpper([]byte(hex.EncodeToString(b))))
+}
+
+//	Decodes the current value. Leading and trailing whitespace is ignored.
+func (me HexBinary) Bytes() ([]byte, error) {
+	b, err := hex.DecodeString(trimXsdWhitespace(string(me)))
+	if err != nil {
+		return nil, &ParseError{Type: "hexBinary", Value: string(me), Err: ErrSyntax}
+	}
+	return b, nil
+}
+
+//	Sets the current value to the canonical encoding of b.
+func (me *HexBinary) SetBytes(b []byte) {
+	*me = HexBinaryOf(b)
+}
+
+//	Like Set, but returns an error if the specified string is not valid hex, in which case the current value is left unchanged.
+func (me *HexBinary) Parse(s string) error {
+	v := HexBinary(trimXsdWhitespace(s))
+	if _, err := hex.DecodeString(string(v)); err != nil {
+		return &ParseError{Type: "hexBinary", Value: s, Err: ErrSyntax}
+	}
+	*me = v
+	return nil
+}
+
+//	Implements encoding.TextMarshaler.
+func (me HexBinary) MarshalText() ([]byte, error) {
+	return []byte(me), nil
+}
+
+//	Implements encoding.TextUnmarshaler, validating the value as Parse does.
+func (me *HexBinary) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Returns a reader that decodes the hex text read from r, ignoring any whitespace in it.
+func NewHexReader(r io.Reader) io.Reader {
+	return hex.NewDecoder(&whitespaceFilter{r})
+}
+
+//	Decodes the hex content of the element whose start tag was just read from d into w, and consumes its end tag.
+func DecodeHexElement(d *xml.Decoder, w io.Writer) (int64, error) {
+	return io.Copy(w, NewHexReader(&charDataReader{d: d}))
+}
+
+//	Writes an element with the given start tag whose content is the canonical hex encoding of everything read from r.
+func EncodeHexElement(e *xml.Encoder, start xml.StartElement, r io.Reader) (int64, error) {
+	return encodeBinaryElement(e, start, r, func(b []byte) []byte {
+		out := make([]byte, hex.EncodedLen(len(b)))
+		hex.Encode(out, b)
+		return bytes.ToUpper(out)
+	})
+}
@@ -0,0 +1,648 @@
+package xsdt
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//	Returned by the Compare methods of the date/time types when XSD leaves the order of two values undefined,
+//	i.e. when exactly one of them carries a timezone and they lie within 14 hours of each other.
+var ErrIndeterminate = errors.New("order is indeterminate")
+
+//	The parsed value of any of the XSD date/time types (dateTime, date, time, gYearMonth, gYear, gMonthDay, gDay
+//	and gMonth), following the seven-property model of XSD 1.1. Properties a type does not have, such as Hour for
+//	a date or Year for a gMonthDay, are left zero.
+//
+//	Years follow XSD 1.1 (and ISO 8601 / time.Time): year 0 is 1 BCE, year -1 is 2 BCE and so on.
+type DateTimeValue struct {
+	Year, Month, Day int
+
+	Hour, Minute, Second, Nanosecond int
+
+	//	Offset from UTC in minutes. Only meaningful if HasTimezone is true.
+	TzOffset int
+
+	HasTimezone bool
+}
+
+//	Returns the timezone of this value as a fixed *time.Location, or time.UTC if there is none.
+func (me DateTimeValue) Location() *time.Location {
+	if !me.HasTimezone || me.TzOffset == 0 {
+		return time.UTC
+	}
+	return time.FixedZone(formatTimezone(me.TzOffset), me.TzOffset*60)
+}
+
+//	Returns this value as a time.Time, in UTC if there is no timezone. A missing Month is taken as 12 and a missing
+//	Day as the last day of the month, so that values of the g* types can be ordered on the time line the way XSD 1.1
+//	does.
+func (me DateTimeValue) Time() time.Time {
+	month, day := me.Month, me.Day
+	if month == 0 {
+		month = 12
+	}
+	if day == 0 {
+		day = time.Date(me.Year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	}
+	return time.Date(me.Year, time.Month(month), day, me.Hour, me.Minute, me.Second, me.Nanosecond, me.Location())
+}
+
+//	Compares two values on the XSD time line, returning -1, 0 or +1. If exactly one of them has a timezone,
+//	the other is assumed to lie anywhere between -14:00 and +14:00, and ErrIndeterminate is returned unless
+//	the outcome is the same for all of those offsets.
+func (me DateTimeValue) Compare(other DateTimeValue) (int, error) {
+	a, b := me.Time(), other.Time()
+	if me.HasTimezone == other.HasTimezone {
+		return a.Compare(b), nil
+	}
+	const spread = 14 * time.Hour
+	if me.HasTimezone {
+		if a.Before(b.Add(-spread)) {
+			return -1, nil
+		} else if a.After(b.Add(spread)) {
+			return 1, nil
+		}
+	} else {
+		if b.Before(a.Add(-spread)) {
+			return 1, nil
+		} else if b.After(a.Add(spread)) {
+			return -1, nil
+		}
+	}
+	return 0, ErrIndeterminate
+}
+
+func timeToValue(t time.Time, withDate, withTime bool) (v DateTimeValue) {
+	if withDate {
+		v.Year, v.Month, v.Day = t.Year(), int(t.Month()), t.Day()
+	}
+	if withTime {
+		v.Hour, v.Minute, v.Second, v.Nanosecond = t.Hour(), t.Minute(), t.Second(), t.Nanosecond()
+	}
+	_, offset := t.Zone()
+	v.TzOffset, v.HasTimezone = offset/60, true
+	return
+}
+
+//	A small cursor over a date/time lexical value.
+type dtScanner struct {
+	s   string
+	err bool
+}
+
+func (me *dtScanner) digits(min, max int) (n int) {
+	i := 0
+	for i < len(me.s) && i < max && me.s[i] >= '0' && me.s[i] <= '9' {
+		n = n*10 + int(me.s[i]-'0')
+		i++
+	}
+	if i < min {
+		me.err = true
+	}
+	me.s = me.s[i:]
+	return
+}
+
+func (me *dtScanner) lit(c byte) {
+	if len(me.s) == 0 || me.s[0] != c {
+		me.err = true
+		return
+	}
+	me.s = me.s[1:]
+}
+
+//	yearFrag ::= '-'? (([1-9] digit digit digit+)) | ('0' digit digit digit))
+func (me *dtScanner) year() int {
+	neg := false
+	if len(me.s) > 0 && me.s[0] == '-' {
+		neg, me.s = true, me.s[1:]
+	}
+	i := 0
+	for i < len(me.s) && me.s[i] >= '0' && me.s[i] <= '9' {
+		i++
+	}
+	if i < 4 || (i > 4 && me.s[0] == '0') || i > 18 {
+		me.err = true
+		return 0
+	}
+	y, _ := strconv.Atoi(me.s[:i])
+	me.s = me.s[i:]
+	if neg {
+		return -y
+	}
+	return y
+}
+
+//	secondFrag ::= ([0-5] digit) ('.' digit+)?
+func (me *dtScanner) seconds() (sec, nsec int) {
+	sec = me.digits(2, 2)
+	if len(me.s) > 0 && me.s[0] == '.' {
+		me.s = me.s[1:]
+		i, scale := 0, 100000000
+		for i < len(me.s) && me.s[i] >= '0' && me.s[i] <= '9' {
+			nsec += int(me.s[i]-'0') * scale
+			scale /= 10
+			i++
+		}
+		if i == 0 {
+			me.err = true
+		}
+		me.s = me.s[i:]
+	}
+	return
+}
+
+//	timezoneFrag ::= 'Z' | ('+' | '-') (('0' digit | '1' [0-3]) ':' minuteFrag | '14:00')
+func (me *dtScanner) timezone(v *DateTimeValue) {
+	if len(me.s) == 0 {
+		return
+	}
+	v.HasTimezone = true
+	if me.s[0] == 'Z' {
+		me.s = me.s[1:]
+		return
+	}
+	sign := 1
+	switch me.s[0] {
+	case '-':
+		sign = -1
+	case '+':
+	default:
+		me.err = true
+		return
+	}
+	me.s = me.s[1:]
+	h := me.digits(2, 2)
+	me.lit(':')
+	m := me.digits(2, 2)
+	if h > 14 || m > 59 || (h == 14 && m != 0) {
+		me.err = true
+	}
+	v.TzOffset = sign * (h*60 + m)
+}
+
+func (me *dtScanner) date(v *DateTimeValue) {
+	v.Year = me.year()
+	me.lit('-')
+	v.Month = me.digits(2, 2)
+	me.lit('-')
+	v.Day = me.digits(2, 2)
+}
+
+func (me *dtScanner) clock(v *DateTimeValue) {
+	v.Hour = me.digits(2, 2)
+	me.lit(':')
+	v.Minute = me.digits(2, 2)
+	me.lit(':')
+	v.Second, v.Nanosecond = me.seconds()
+}
+
+func daysIn(year, month int) int {
+	switch month {
+	case 2:
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 29
+		}
+		return 28
+	case 4, 6, 9, 11:
+		return 30
+	}
+	return 31
+}
+
+//	Checks the ranges of all properties, and maps the XSD 1.1 end-of-day form 24:00:00 to 00:00:00 of the following day.
+func (me *DateTimeValue) normalize(hasYear, hasMonth, hasDay, hasTime bool) bool {
+	if hasMonth && (me.Month < 1 || me.Month > 12) {
+		return false
+	}
+	if hasDay {
+		max := 31
+		if hasMonth {
+			if hasYear {
+				max = daysIn(me.Year, me.Month)
+			} else {
+				max = daysIn(2000, me.Month)
+			}
+		}
+		if me.Day < 1 || me.Day > max {
+			return false
+		}
+	}
+	if hasTime {
+		if me.Hour == 24 {
+			if me.Minute != 0 || me.Second != 0 || me.Nanosecond != 0 {
+				return false
+			}
+			me.Hour = 0
+			if hasDay {
+				next := time.Date(me.Year, time.Month(me.Month), me.Day+1, 0, 0, 0, 0, time.UTC)
+				me.Year, me.Month, me.Day = next.Year(), int(next.Month()), next.Day()
+			}
+		}
+		if me.Hour > 23 || me.Minute > 59 || me.Second > 59 {
+			return false
+		}
+	}
+	return true
+}
+
+//	The lexical layouts of the eight date/time types.
+const (
+	dtDateTime = iota
+	dtDate
+	dtTime
+	dtGYearMonth
+	dtGYear
+	dtGMonthDay
+	dtGDay
+	dtGMonth
+)
+
+var dtNames = [...]string{"dateTime", "date", "time", "gYearMonth", "gYear", "gMonthDay", "gDay", "gMonth"}
+
+func parseDateTimeValue(kind int, s string) (v DateTimeValue, err error) {
+	sc := &dtScanner{s: trimXsdWhitespace(s)}
+	var hasYear, hasMonth, hasDay, hasTime bool
+	switch kind {
+	case dtDateTime:
+		sc.date(&v)
+		sc.lit('T')
+		sc.clock(&v)
+		hasYear, hasMonth, hasDay, hasTime = true, true, true, true
+	case dtDate:
+		sc.date(&v)
+		hasYear, hasMonth, hasDay = true, true, true
+	case dtTime:
+		sc.clock(&v)
+		hasTime = true
+	case dtGYearMonth:
+		v.Year = sc.year()
+		sc.lit('-')
+		v.Month = sc.digits(2, 2)
+		hasYear, hasMonth = true, true
+	case dtGYear:
+		v.Year = sc.year()
+		hasYear = true
+	case dtGMonthDay:
+		sc.lit('-')
+		sc.lit('-')
+		v.Month = sc.digits(2, 2)
+		sc.lit('-')
+		v.Day = sc.digits(2, 2)
+		hasMonth, hasDay = true, true
+	case dtGDay:
+		sc.lit('-')
+		sc.lit('-')
+		sc.lit('-')
+		v.Day = sc.digits(2, 2)
+		hasDay = true
+	case dtGMonth:
+		sc.lit('-')
+		sc.lit('-')
+		v.Month = sc.digits(2, 2)
+		hasMonth = true
+	}
+	if !sc.err {
+		sc.timezone(&v)
+	}
+	if sc.err || len(sc.s) > 0 {
+		return DateTimeValue{}, &ParseError{Type: dtNames[kind], Value: s, Err: ErrSyntax}
+	}
+	if !v.normalize(hasYear, hasMonth, hasDay, hasTime) {
+		return DateTimeValue{}, &ParseError{Type: dtNames[kind], Value: s, Err: ErrRange}
+	}
+	return
+}
+
+func formatYear(b *strings.Builder, year int) {
+	if year < 0 {
+		b.WriteByte('-')
+		year = -year
+	}
+	y := strconv.Itoa(year)
+	for i := len(y); i < 4; i++ {
+		b.WriteByte('0')
+	}
+	b.WriteString(y)
+}
+
+func format2(b *strings.Builder, n int) {
+	b.WriteByte(byte('0' + n/10))
+	b.WriteByte(byte('0' + n%10))
+}
+
+func formatTimezone(offset int) string {
+	if offset == 0 {
+		return "Z"
+	}
+	var b strings.Builder
+	if offset < 0 {
+		b.WriteByte('-')
+		offset = -offset
+	} else {
+		b.WriteByte('+')
+	}
+	format2(&b, offset/60)
+	b.WriteByte(':')
+	format2(&b, offset%60)
+	return b.String()
+}
+
+//	Produces the canonical lexical form of v for the given kind: fractional seconds without trailing zeros,
+//	at least four year digits, and "Z" for a zero offset.
+func formatDateTimeValue(kind int, v DateTimeValue) string {
+	var b strings.Builder
+	switch kind {
+	case dtDateTime, dtDate:
+		formatYear(&b, v.Year)
+		b.WriteByte('-')
+		format2(&b, v.Month)
+		b.WriteByte('-')
+		format2(&b, v.Day)
+	case dtGYearMonth:
+		formatYear(&b, v.Year)
+		b.WriteByte('-')
+		format2(&b, v.Month)
+	case dtGYear:
+		formatYear(&b, v.Year)
+	case dtGMonthDay:
+		b.WriteString("--")
+		format2(&b, v.Month)
+		b.WriteByte('-')
+		format2(&b, v.Day)
+	case dtGDay:
+		b.WriteString("---")
+		format2(&b, v.Day)
+	case dtGMonth:
+		b.WriteString("--")
+		format2(&b, v.Month)
+	}
+	if kind == dtDateTime {
+		b.WriteByte('T')
+	}
+	if kind == dtDateTime || kind == dtTime {
+		format2(&b, v.Hour)
+		b.WriteByte(':')
+		format2(&b, v.Minute)
+		b.WriteByte(':')
+		format2(&b, v.Second)
+		if v.Nanosecond > 0 {
+			frac := strconv.Itoa(1000000000 + v.Nanosecond)[1:]
+			b.WriteByte('.')
+			b.WriteString(strings.TrimRight(frac, "0"))
+		}
+	}
+	if v.HasTimezone {
+		b.WriteString(formatTimezone(v.TzOffset))
+	}
+	return b.String()
+}
+
+func compareDateTimeValues(kind int, a, b string) (int, error) {
+	va, err := parseDateTimeValue(kind, a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseDateTimeValue(kind, b)
+	if err != nil {
+		return 0, err
+	}
+	return va.Compare(vb)
+}
+
+//	Parses the current xs:dateTime value.
+func (me DateTime) Value() (DateTimeValue, error) {
+	return parseDateTimeValue(dtDateTime, string(me))
+}
+
+//	Like Set, but returns an error if the specified string is not a valid xs:dateTime lexical value, in which case the current value is left unchanged.
+func (me *DateTime) Parse(s string) error {
+	_, err := parseDateTimeValue(dtDateTime, s)
+	if err == nil {
+		*me = DateTime(trimXsdWhitespace(s))
+	}
+	return err
+}
+
+//	Sets the current value to the canonical lexical form of v.
+func (me *DateTime) SetValue(v DateTimeValue) {
+	*me = DateTime(formatDateTimeValue(dtDateTime, v))
+}
+
+//	Sets the current value from t, keeping its timezone offset.
+func (me *DateTime) SetTime(t time.Time) {
+	me.SetValue(timeToValue(t, true, true))
+}
+
+//	Returns the current value as a time.Time. A value without timezone is returned in UTC.
+func (me DateTime) Time() (time.Time, error) {
+	v, err := me.Value()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return v.Time(), nil
+}
+
+//	Compares this value with other on the XSD time line, taking timezones into account (see DateTimeValue.Compare).
+func (me DateTime) Compare(other DateTime) (int, error) {
+	return compareDateTimeValues(dtDateTime, string(me), string(other))
+}
+
+//	Parses the current xs:date value.
+func (me Date) Value() (DateTimeValue, error) {
+	return parseDateTimeValue(dtDate, string(me))
+}
+
+//	Like Set, but returns an error if the specified string is not a valid xs:date lexical value, in which case the current value is left unchanged.
+func (me *Date) Parse(s string) error {
+	_, err := parseDateTimeValue(dtDate, s)
+	if err == nil {
+		*me = Date(trimXsdWhitespace(s))
+	}
+	return err
+}
+
+//	Sets the current value to the canonical lexical form of v.
+func (me *Date) SetValue(v DateTimeValue) {
+	*me = Date(formatDateTimeValue(dtDate, v))
+}
+
+//	Sets the current value to the calendar date of t, keeping its timezone offset.
+func (me *Date) SetTime(t time.Time) {
+	me.SetValue(timeToValue(t, true, false))
+}
+
+//	Returns the first instant of the current date as a time.Time. A value without timezone is returned in UTC.
+func (me Date) Time() (time.Time, error) {
+	v, err := me.Value()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return v.Time(), nil
+}
+
+//	Compares this value with other on the XSD time line, taking timezones into account (see DateTimeValue.Compare).
+func (me Date) Compare(other Date) (int, error) {
+	return compareDateTimeValues(dtDate, string(me), string(other))
+}
+
+//	Parses the current xs:time value.
+func (me Time) Value() (DateTimeValue, error) {
+	return parseDateTimeValue(dtTime, string(me))
+}
+
+//	Like Set, but returns an error if the specified string is not a valid xs:time lexical value, in which case the current value is left unchanged.
+func (me *Time) Parse(s string) error {
+	_, err := parseDateTimeValue(dtTime, s)
+	if err == nil {
+		*me = Time(trimXsdWhitespace(s))
+	}
+	return err
+}
+
+//	Sets the current value to the canonical lexical form of v.
+func (me *Time) SetValue(v DateTimeValue) {
+	*me = Time(formatDateTimeValue(dtTime, v))
+}
+
+//	Sets the current value to the time of day of t, keeping its timezone offset.
+func (me *Time) SetTime(t time.Time) {
+	me.SetValue(timeToValue(t, false, true))
+}
+
+//	Returns the current value as a time.Time on the XSD reference date 1972-12-31. A value without timezone is returned in UTC.
+func (me Time) Time() (time.Time, error) {
+	v, err := me.Value()
+	if err != nil {
+		return time.Time{}, err
+	}
+	v.Year = 1972
+	return v.Time(), nil
+}
+
+//	Compares this value with other on the XSD time line, taking timezones into account (see DateTimeValue.Compare).
+func (me Time) Compare(other Time) (int, error) {
+	return compareDateTimeValues(dtTime, string(me), string(other))
+}
+
+//	Parses the current xs:gYearMonth value. Only Year, Month and the timezone are set.
+func (me GYearMonth) Value() (DateTimeValue, error) {
+	return parseDateTimeValue(dtGYearMonth, string(me))
+}
+
+//	Like Set, but returns an error if the specified string is not a valid xs:gYearMonth lexical value, in which case the current value is left unchanged.
+func (me *GYearMonth) Parse(s string) error {
+	_, err := parseDateTimeValue(dtGYearMonth, s)
+	if err == nil {
+		*me = GYearMonth(trimXsdWhitespace(s))
+	}
+	return err
+}
+
+//	Sets the current value to the canonical lexical form of the Year, Month and timezone of v.
+func (me *GYearMonth) SetValue(v DateTimeValue) {
+	*me = GYearMonth(formatDateTimeValue(dtGYearMonth, v))
+}
+
+//	Compares this value with other, taking timezones into account (see DateTimeValue.Compare).
+func (me GYearMonth) Compare(other GYearMonth) (int, error) {
+	return compareDateTimeValues(dtGYearMonth, string(me), string(other))
+}
+
+//	Parses the current xs:gYear value. Only Year and the timezone are set.
+func (me GYear) Value() (DateTimeValue, error) {
+	return parseDateTimeValue(dtGYear, string(me))
+}
+
+//	Like Set, but returns an error if the specified string is not a valid xs:gYear lexical value, in which case the current value is left unchanged.
+func (me *GYear) Parse(s string) error {
+	_, err := parseDateTimeValue(dtGYear, s)
+	if err == nil {
+		*me = GYear(trimXsdWhitespace(s))
+	}
+	return err
+}
+
+//	Sets the current value to the canonical lexical form of the Year and timezone of v.
+func (me *GYear) SetValue(v DateTimeValue) {
+	*me = GYear(formatDateTimeValue(dtGYear, v))
+}
+
+//	Compares this value with other, taking timezones into account (see DateTimeValue.Compare).
+func (me GYear) Compare(other GYear) (int, error) {
+	return compareDateTimeValues(dtGYear, string(me), string(other))
+}
+
+//	Parses the current xs:gMonthDay value. Only Month, Day and the timezone are set.
+func (me GMonthDay) Value() (DateTimeValue, error) {
+	return parseDateTimeValue(dtGMonthDay, string(me))
+}
+
+//	Like Set, but returns an error if the specified string is not a valid xs:gMonthDay lexical value, in which case the current value is left unchanged.
+func (me *GMonthDay) Parse(s string) error {
+	_, err := parseDateTimeValue(dtGMonthDay, s)
+	if err == nil {
+		*me = GMonthDay(trimXsdWhitespace(s))
+	}
+	return err
+}
+
+//	Sets the current value to the canonical lexical form of the Month, Day and timezone of v.
+func (me *GMonthDay) SetValue(v DateTimeValue) {
+	*me = GMonthDay(formatDateTimeValue(dtGMonthDay, v))
+}
+
+//	Compares this value with other, taking timezones into account (see DateTimeValue.Compare).
+func (me GMonthDay) Compare(other GMonthDay) (int, error) {
+	return compareDateTimeValues(dtGMonthDay, string(me), string(other))
+}
+
+//	Parses the current xs:gDay value. Only Day and the timezone are set.
+func (me GDay) Value() (DateTimeValue, error) {
+	return parseDateTimeValue(dtGDay, string(me))
+}
+
+//	Like Set, but returns an error if the specified string is not a valid xs:gDay lexical value, in which case the current value is left unchanged.
+func (me *GDay) Parse(s string) error {
+	_, err := parseDateTimeValue(dtGDay, s)
+	if err == nil {
+		*me = GDay(trimXsdWhitespace(s))
+	}
+	return err
+}
+
+//	Sets the current value to the canonical lexical form of the Day and timezone of v.
+func (me *GDay) SetValue(v DateTimeValue) {
+	*me = GDay(formatDateTimeValue(dtGDay, v))
+}
+
+//	Compares this value with other, taking timezones into account (see DateTimeValue.Compare).
+func (me GDay) Compare(other GDay) (int, error) {
+	return compareDateTimeValues(dtGDay, string(me), string(other))
+}
+
+//	Parses the current xs:gMonth value. Only Month and the timezone are set.
+func (me GMonth) Value() (DateTimeValue, error) {
+	return parseDateTimeValue(dtGMonth, string(me))
+}
+
+//	Like Set, but returns an error if the specified string is not a valid xs:gMonth lexical value, in which case the current value is left unchanged.
+func (me *GMonth) Parse(s string) error {
+	_, err := parseDateTimeValue(dtGMonth, s)
+	if err == nil {
+		*me = GMonth(trimXsdWhitespace(s))
+	}
+	return err
+}
+
+//	Sets the current value to the canonical lexical form of the Month and timezone of v.
+func (me *GMonth) SetValue(v DateTimeValue) {
+	*me = GMonth(formatDateTimeValue(dtGMonth, v))
+}
+
+//	Compares this value with other, taking timezones into account (see DateTimeValue.Compare).
+func (me GMonth) Compare(other GMonth) (int, error) {
+	return compareDateTimeValues(dtGMonth, string(me), string(other))
+}
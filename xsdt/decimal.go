@@ -0,0 +1,144 @@
+package xsdt
+
+import (
+	"errors"
+	"math/big"
+)
+
+//	Returned by DecimalOf when a rational number has no finite decimal representation, e.g. 1/3.
+var ErrNotDecimal = errors.New("not a finite decimal")
+
+//	Returns the Integer with the value n.
+func NewInteger(n int64) Integer {
+	return Integer{n: big.NewInt(n)}
+}
+
+//	Returns the Integer with the value n, which is copied.
+func IntegerOf(n *big.Int) Integer {
+	return Integer{n: new(big.Int).Set(n)}
+}
+
+//	Reports whether the Integer has no value, so that JSON fields tagged omitzero leave it out.
+func (me Integer) IsZero() bool {
+	return me.n == nil
+}
+
+//	Returns the current value as a newly allocated *big.Int. An Integer without value counts as 0 here and in the arithmetic below.
+func (me Integer) Big() *big.Int {
+	if me.n == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(me.n)
+}
+
+//	Compares the current value with other, returning -1, 0 or +1.
+func (me Integer) Cmp(other Integer) int {
+	return me.Big().Cmp(other.Big())
+}
+
+//	Returns the sum of the current value and other.
+func (me Integer) Add(other Integer) Integer {
+	a := me.Big()
+	return Integer{n: a.Add(a, other.Big())}
+}
+
+//	Returns the difference of the current value and other.
+func (me Integer) Sub(other Integer) Integer {
+	a := me.Big()
+	return Integer{n: a.Sub(a, other.Big())}
+}
+
+//	Returns the product of the current value and other.
+func (me Integer) Mul(other Integer) Integer {
+	a := me.Big()
+	return Integer{n: a.Mul(a, other.Big())}
+}
+
+//	Returns the current value as a Decimal. Every integer is a decimal.
+func (me Integer) Decimal() Decimal {
+	if me.n == nil {
+		return Decimal{}
+	}
+	return Decimal{r: new(big.Rat).SetInt(me.n)}
+}
+
+//	Returns the Decimal with the value r, which is copied, or ErrNotDecimal if r has no finite decimal expansion.
+func DecimalOf(r *big.Rat) (Decimal, error) {
+	if _, ok := decimalPlaces(r); !ok {
+		return Decimal{}, ErrNotDecimal
+	}
+	return Decimal{r: new(big.Rat).Set(r)}, nil
+}
+
+//	Returns the number of fractional digits r needs, and whether it has a finite decimal expansion at all.
+func decimalPlaces(r *big.Rat) (int, bool) {
+	if r.IsInt() {
+		return 0, true
+	}
+	//	A fraction in lowest terms terminates iff its denominator is 2^a * 5^b, and then needs max(a, b) digits.
+	d := new(big.Int).Set(r.Denom())
+	rem, two, five := new(big.Int), big.NewInt(2), big.NewInt(5)
+	twos, fives := 0, 0
+	for q := new(big.Int); ; twos++ {
+		if q.QuoRem(d, two, rem); rem.Sign() != 0 {
+			break
+		}
+		d.Set(q)
+	}
+	for q := new(big.Int); ; fives++ {
+		if q.QuoRem(d, five, rem); rem.Sign() != 0 {
+			break
+		}
+		d.Set(q)
+	}
+	if d.Cmp(big.NewInt(1)) != 0 {
+		return 0, false
+	}
+	if fives > twos {
+		twos = fives
+	}
+	return twos, true
+}
+
+//	Reports whether the Decimal has no value, so that JSON fields tagged omitzero leave it out.
+func (me Decimal) IsZero() bool {
+	return me.r == nil
+}
+
+//	Returns the current value as a newly allocated *big.Rat, which represents it exactly. A Decimal without value
+//	counts as 0 here and in the arithmetic below.
+func (me Decimal) Rat() *big.Rat {
+	if me.r == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(me.r)
+}
+
+//	Returns the current value as the nearest float64.
+func (me Decimal) Float64() float64 {
+	f, _ := me.Rat().Float64()
+	return f
+}
+
+//	Compares the current value with other, returning -1, 0 or +1.
+func (me Decimal) Cmp(other Decimal) int {
+	return me.Rat().Cmp(other.Rat())
+}
+
+//	Returns the sum of the current value and other. Sums, differences and products of decimals are decimals again.
+func (me Decimal) Add(other Decimal) Decimal {
+	a := me.Rat()
+	return Decimal{r: a.Add(a, other.Rat())}
+}
+
+//	Returns the difference of the current value and other.
+func (me Decimal) Sub(other Decimal) Decimal {
+	a := me.Rat()
+	return Decimal{r: a.Sub(a, other.Rat())}
+}
+
+//	Returns the product of the current value and other.
+func (me Decimal) Mul(other Decimal) Decimal {
+	a := me.Rat()
+	return Decimal{r: a.Mul(a, other.Rat())}
+}
@@ -0,0 +1,276 @@
+package xsdt
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//	Returned by DurationValue.TimeDuration when a duration has a year or month component, whose length in
+//	seconds depends on the date it is applied to, or when it does not fit a time.Duration.
+var ErrInexact = errors.New("duration cannot be represented exactly")
+
+//	The parsed value of an xs:duration in its ISO 8601 PnYnMnDTnHnMnS form. All components are non-negative;
+//	Negative applies to the duration as a whole. Fractional seconds are kept to nanosecond precision.
+type DurationValue struct {
+	Negative bool
+
+	Years, Months, Days int
+
+	Hours, Minutes, Seconds, Nanoseconds int
+}
+
+//	Returns the XSD 1.1 months property of this duration: years and months folded into a signed month count.
+func (me DurationValue) TotalMonths() int {
+	n := me.Years*12 + me.Months
+	if me.Negative {
+		return -n
+	}
+	return n
+}
+
+//	Returns the XSD 1.1 seconds property of this duration, split into whole seconds and nanoseconds, both
+//	carrying the sign of the duration.
+func (me DurationValue) TotalSeconds() (sec int, nsec int) {
+	sec = ((me.Days*24+me.Hours)*60+me.Minutes)*60 + me.Seconds + me.Nanoseconds/1000000000
+	nsec = me.Nanoseconds % 1000000000
+	if me.Negative {
+		return -sec, -nsec
+	}
+	return
+}
+
+//	Returns the equivalent duration with months carried into years, and seconds carried into minutes, hours
+//	and days. Days are never carried into months, since their relation depends on the calendar. The result
+//	is what String produces the canonical lexical form from.
+func (me DurationValue) Normalize() DurationValue {
+	months := me.Years*12 + me.Months
+	sec := ((me.Days*24+me.Hours)*60+me.Minutes)*60 + me.Seconds + me.Nanoseconds/1000000000
+	return DurationValue{
+		Negative:    me.Negative && (months != 0 || sec != 0 || me.Nanoseconds%1000000000 != 0),
+		Years:       months / 12,
+		Months:      months % 12,
+		Days:        sec / 86400,
+		Hours:       sec % 86400 / 3600,
+		Minutes:     sec % 3600 / 60,
+		Seconds:     sec % 60,
+		Nanoseconds: me.Nanoseconds % 1000000000,
+	}
+}
+
+//	Returns the duration as a time.Duration. This is only exact, and therefore only allowed, if the
+//	duration has no year or month component; otherwise ErrInexact is returned.
+func (me DurationValue) TimeDuration() (time.Duration, error) {
+	if me.Years != 0 || me.Months != 0 {
+		return 0, ErrInexact
+	}
+	sec, nsec := me.TotalSeconds()
+	if sec > math.MaxInt64/int(time.Second)-1 || sec < math.MinInt64/int(time.Second)+1 {
+		return 0, ErrInexact
+	}
+	return time.Duration(sec)*time.Second + time.Duration(nsec), nil
+}
+
+//	Adds this duration to v following the algorithm of XSD 1.0 Appendix E: the year and month components
+//	are added first, the day is pinned to the last day of the resulting month if necessary (so 2000-01-31
+//	plus P1M is 2000-02-29), then days, hours, minutes and seconds are added. The timezone of v is kept.
+func (me DurationValue) AddTo(v DateTimeValue) DateTimeValue {
+	months, sign := v.Month-1+me.TotalMonths(), 1
+	if me.Negative {
+		sign = -1
+	}
+	year := v.Year + floorDiv(months, 12)
+	month := months - floorDiv(months, 12)*12 + 1
+	day := v.Day
+	if max := daysIn(year, month); day > max {
+		day = max
+	} else if day < 1 {
+		day = 1
+	}
+	t := time.Date(year, time.Month(month), day+sign*me.Days,
+		v.Hour+sign*me.Hours, v.Minute+sign*me.Minutes, v.Second+sign*me.Seconds,
+		v.Nanosecond+sign*me.Nanoseconds, time.UTC)
+	res := timeToValue(t, true, true)
+	res.TzOffset, res.HasTimezone = v.TzOffset, v.HasTimezone
+	return res
+}
+
+//	Returns the canonical lexical form of this duration.
+func (me DurationValue) String() string {
+	n := me.Normalize()
+	var b strings.Builder
+	if n.Negative {
+		b.WriteByte('-')
+	}
+	b.WriteByte('P')
+	if n.Years != 0 {
+		b.WriteString(strconv.Itoa(n.Years) + "Y")
+	}
+	if n.Months != 0 {
+		b.WriteString(strconv.Itoa(n.Months) + "M")
+	}
+	if n.Days != 0 {
+		b.WriteString(strconv.Itoa(n.Days) + "D")
+	}
+	if n.Hours != 0 || n.Minutes != 0 || n.Seconds != 0 || n.Nanoseconds != 0 {
+		b.WriteByte('T')
+		if n.Hours != 0 {
+			b.WriteString(strconv.Itoa(n.Hours) + "H")
+		}
+		if n.Minutes != 0 {
+			b.WriteString(strconv.Itoa(n.Minutes) + "M")
+		}
+		if n.Seconds != 0 || n.Nanoseconds != 0 {
+			b.WriteString(strconv.Itoa(n.Seconds))
+			if n.Nanoseconds != 0 {
+				b.WriteByte('.')
+				b.WriteString(strings.TrimRight(strconv.Itoa(1000000000 + n.Nanoseconds)[1:], "0"))
+			}
+			b.WriteByte('S')
+		}
+	}
+	if strings.HasSuffix(b.String(), "P") {
+		return "PT0S"
+	}
+	return b.String()
+}
+
+//	Returns the duration represented by d, which is always exact.
+func DurationValueOf(d time.Duration) (v DurationValue) {
+	if d < 0 {
+		v.Negative = true
+	}
+	sec, nsec := int(d/time.Second), int(d%time.Second)
+	if v.Negative {
+		sec, nsec = -sec, -nsec
+	}
+	v.Seconds, v.Nanoseconds = sec, nsec
+	return v.Normalize()
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+func parseDurationValue(s string) (v DurationValue, err error) {
+	syntaxErr := &ParseError{Type: "duration", Value: s, Err: ErrSyntax}
+	rest := trimXsdWhitespace(s)
+	if strings.HasPrefix(rest, "-") {
+		v.Negative, rest = true, rest[1:]
+	}
+	if !strings.HasPrefix(rest, "P") {
+		return DurationValue{}, syntaxErr
+	}
+	rest = rest[1:]
+	datePart, timePart, hasTime := strings.Cut(rest, "T")
+	if (len(datePart) == 0 && !hasTime) || (hasTime && len(timePart) == 0) {
+		return DurationValue{}, syntaxErr
+	}
+	fields := []struct {
+		part       *string
+		designator byte
+		dest       *int
+	}{
+		{&datePart, 'Y', &v.Years},
+		{&datePart, 'M', &v.Months},
+		{&datePart, 'D', &v.Days},
+		{&timePart, 'H', &v.Hours},
+		{&timePart, 'M', &v.Minutes},
+	}
+	for _, f := range fields {
+		i := 0
+		for i < len(*f.part) && (*f.part)[i] >= '0' && (*f.part)[i] <= '9' {
+			i++
+		}
+		if i == len(*f.part) || (*f.part)[i] != f.designator {
+			continue
+		}
+		if i == 0 {
+			return DurationValue{}, syntaxErr
+		}
+		n, e := strconv.Atoi((*f.part)[:i])
+		if e != nil {
+			return DurationValue{}, &ParseError{Type: "duration", Value: s, Err: ErrRange}
+		}
+		*f.dest, *f.part = n, (*f.part)[i+1:]
+	}
+	if len(datePart) > 0 {
+		return DurationValue{}, syntaxErr
+	}
+	if len(timePart) > 0 {
+		num, ok := strings.CutSuffix(timePart, "S")
+		if !ok || !isDecimalLexical(num) || num[0] == '+' || num[0] == '-' {
+			return DurationValue{}, syntaxErr
+		}
+		whole, frac, _ := strings.Cut(num, ".")
+		if len(whole) > 0 {
+			n, e := strconv.Atoi(whole)
+			if e != nil {
+				return DurationValue{}, &ParseError{Type: "duration", Value: s, Err: ErrRange}
+			}
+			v.Seconds = n
+		}
+		for i, scale := 0, 100000000; i < len(frac) && scale > 0; i, scale = i+1, scale/10 {
+			v.Nanoseconds += int(frac[i]-'0') * scale
+		}
+	}
+	return
+}
+
+//	Parses the current xs:duration value.
+func (me Duration) Value() (DurationValue, error) {
+	return parseDurationValue(string(me))
+}
+
+//	Like Set, but returns an error if the specified string is not a valid xs:duration lexical value, in which case the current value is left unchanged.
+func (me *Duration) Parse(s string) error {
+	_, err := parseDurationValue(s)
+	if err == nil {
+		*me = Duration(trimXsdWhitespace(s))
+	}
+	return err
+}
+
+//	Sets the current value to the canonical lexical form of v.
+func (me *Duration) SetValue(v DurationValue) {
+	*me = Duration(v.String())
+}
+
+//	Returns the current value in its canonical lexical form, e.g. "PT36H" becomes "P1DT12H".
+func (me Duration) Normalize() (Duration, error) {
+	v, err := me.Value()
+	if err != nil {
+		return me, err
+	}
+	return Duration(v.String()), nil
+}
+
+//	Returns the current value as a time.Duration, if it has no year or month component (see DurationValue.TimeDuration).
+func (me Duration) TimeDuration() (time.Duration, error) {
+	v, err := me.Value()
+	if err != nil {
+		return 0, err
+	}
+	return v.TimeDuration()
+}
+
+//	Adds the current duration to dt using the XSD calendar rules (see DurationValue.AddTo).
+func (me Duration) AddTo(dt DateTime) (DateTime, error) {
+	d, err := me.Value()
+	if err != nil {
+		return dt, err
+	}
+	v, err := dt.Value()
+	if err != nil {
+		return dt, err
+	}
+	var res DateTime
+	res.SetValue(d.AddTo(v))
+	return res, nil
+}
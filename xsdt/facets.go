@@ -0,0 +1,577 @@
+package xsdt
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+//	Returned by the Validate method of a Restriction when a value does not satisfy one of its constraining facets.
+type FacetError struct {
+	//	The name of the restricted type, e.g. "LOCTYPE".
+	Type string
+
+	//	The offending value, after whitespace normalization.
+	Value string
+
+	//	The facet that failed, as written in a schema, e.g. "maxLength" or "enumeration".
+	Facet string
+
+	//	The facet value, e.g. "8" or "ARK|URN|URL".
+	Constraint string
+
+	//	What was found instead, e.g. "length 9", if there is more to say than that the facet failed.
+	Detail string
+}
+
+func (me *FacetError) Error() string {
+	msg := "xsdt: " + strconv.Quote(me.Value) + " is not a valid " + me.Type + ": violates " + me.Facet + " facet " + me.Constraint
+	if me.Detail != "" {
+		msg += " (" + me.Detail + ")"
+	}
+	return msg
+}
+
+//	The kinds of built-in base types, which determine which facets apply and how.
+type baseKind int
+
+const (
+	kindString baseKind = iota
+	kindList
+	kindQName
+	kindBoolean
+	kindDecimal
+	kindFloat
+	kindDuration
+	kindDateTime
+	kindHexBinary
+	kindBase64Binary
+)
+
+//	A built-in type that restrictions can be derived from.
+type builtinBase struct {
+	kind  baseKind
+	ws    WhiteSpace
+	dt    int
+	check func(string) error
+}
+
+func parseCheck[T any, PT interface {
+	*T
+	Parse(string) error
+}]() func(string) error {
+	return func(s string) error {
+		var v T
+		return PT(&v).Parse(s)
+	}
+}
+
+func validateCheck[T interface {
+	~string
+	Validate() error
+}]() func(string) error {
+	return func(s string) error {
+		return T(s).Validate()
+	}
+}
+
+func listCheck[T ~string](typ string) func(string) error {
+	return func(s string) error {
+		list := ListOf[T](s)
+		if len(list) == 0 {
+			return &ValidationError{Type: typ, Value: s, Rule: "non-empty list", Offset: -1}
+		}
+		return list.Validate()
+	}
+}
+
+//	The built-in types by XSD name, with what the facet engine needs to know about them.
+var builtinBases = map[string]builtinBase{
+	"string":             {kind: kindString, ws: WhiteSpacePreserve},
+	"normalizedString":   {kind: kindString, ws: WhiteSpaceReplace},
+	"token":              {kind: kindString, ws: WhiteSpaceCollapse},
+	"language":           {kind: kindString, ws: WhiteSpaceCollapse, check: validateCheck[Language]()},
+	"Name":               {kind: kindString, ws: WhiteSpaceCollapse, check: validateCheck[Name]()},
+	"NCName":             {kind: kindString, ws: WhiteSpaceCollapse, check: validateCheck[NCName]()},
+	"ID":                 {kind: kindString, ws: WhiteSpaceCollapse, check: validateCheck[Id]()},
+	"IDREF":              {kind: kindString, ws: WhiteSpaceCollapse, check: validateCheck[Idref]()},
+	"ENTITY":             {kind: kindString, ws: WhiteSpaceCollapse, check: validateCheck[Entity]()},
+	"NMTOKEN":            {kind: kindString, ws: WhiteSpaceCollapse, check: validateCheck[Nmtoken]()},
+	"anyURI":             {kind: kindString, ws: WhiteSpaceCollapse, check: validateCheck[AnyURI]()},
+	"IDREFS":             {kind: kindList, ws: WhiteSpaceCollapse, check: listCheck[Idref]("IDREFS")},
+	"NMTOKENS":           {kind: kindList, ws: WhiteSpaceCollapse, check: listCheck[Nmtoken]("NMTOKENS")},
+	"ENTITIES":           {kind: kindList, ws: WhiteSpaceCollapse, check: listCheck[Entity]("ENTITIES")},
+	"QName":              {kind: kindQName, ws: WhiteSpaceCollapse, check: validateCheck[Qname]()},
+	"NOTATION":           {kind: kindQName, ws: WhiteSpaceCollapse, check: validateCheck[Qname]()},
+	"boolean":            {kind: kindBoolean, ws: WhiteSpaceCollapse, check: parseCheck[Boolean]()},
+	"decimal":            {kind: kindDecimal, ws: WhiteSpaceCollapse, check: parseCheck[Decimal]()},
+	"integer":            {kind: kindDecimal, ws: WhiteSpaceCollapse, check: parseCheck[Integer]()},
+	"long":               {kind: kindDecimal, ws: WhiteSpaceCollapse, check: parseCheck[Long]()},
+	"int":                {kind: kindDecimal, ws: WhiteSpaceCollapse, check: parseCheck[Int]()},
+	"short":              {kind: kindDecimal, ws: WhiteSpaceCollapse, check: parseCheck[Short]()},
+	"byte":               {kind: kindDecimal, ws: WhiteSpaceCollapse, check: parseCheck[Byte]()},
+	"nonNegativeInteger": {kind: kindDecimal, ws: WhiteSpaceCollapse, check: parseCheck[NonNegativeInteger]()},
+	"positiveInteger":    {kind: kindDecimal, ws: WhiteSpaceCollapse, check: parseCheck[PositiveInteger]()},
+	"nonPositiveInteger": {kind: kindDecimal, ws: WhiteSpaceCollapse, check: parseCheck[NonPositiveInteger]()},
+	"negativeInteger":    {kind: kindDecimal, ws: WhiteSpaceCollapse, check: parseCheck[NegativeInteger]()},
+	"unsignedLong":       {kind: kindDecimal, ws: WhiteSpaceCollapse, check: parseCheck[UnsignedLong]()},
+	"unsignedInt":        {kind: kindDecimal, ws: WhiteSpaceCollapse, check: parseCheck[UnsignedInt]()},
+	"unsignedShort":      {kind: kindDecimal, ws: WhiteSpaceCollapse, check: parseCheck[UnsignedShort]()},
+	"unsignedByte":       {kind: kindDecimal, ws: WhiteSpaceCollapse, check: parseCheck[UnsignedByte]()},
+	"float":              {kind: kindFloat, ws: WhiteSpaceCollapse, check: parseCheck[Float]()},
+	"double":             {kind: kindFloat, ws: WhiteSpaceCollapse, check: parseCheck[Double]()},
+	"duration":           {kind: kindDuration, ws: WhiteSpaceCollapse, check: parseCheck[Duration]()},
+	"dateTime":           {kind: kindDateTime, ws: WhiteSpaceCollapse, dt: dtDateTime, check: parseCheck[DateTime]()},
+	"date":               {kind: kindDateTime, ws: WhiteSpaceCollapse, dt: dtDate, check: parseCheck[Date]()},
+	"time":               {kind: kindDateTime, ws: WhiteSpaceCollapse, dt: dtTime, check: parseCheck[Time]()},
+	"gYearMonth":         {kind: kindDateTime, ws: WhiteSpaceCollapse, dt: dtGYearMonth, check: parseCheck[GYearMonth]()},
+	"gYear":              {kind: kindDateTime, ws: WhiteSpaceCollapse, dt: dtGYear, check: parseCheck[GYear]()},
+	"gMonthDay":          {kind: kindDateTime, ws: WhiteSpaceCollapse, dt: dtGMonthDay, check: parseCheck[GMonthDay]()},
+	"gDay":               {kind: kindDateTime, ws: WhiteSpaceCollapse, dt: dtGDay, check: parseCheck[GDay]()},
+	"gMonth":             {kind: kindDateTime, ws: WhiteSpaceCollapse, dt: dtGMonth, check: parseCheck[GMonth]()},
+	"hexBinary":          {kind: kindHexBinary, ws: WhiteSpaceCollapse, check: parseCheck[HexBinary]()},
+	"base64Binary":       {kind: kindBase64Binary, ws: WhiteSpaceCollapse, check: parseCheck[Base64Binary]()},
+}
+
+//	The reference instants of XSD 1.0 section 3.2.6.2, against which durations are ordered.
+var durationReferences = []DateTimeValue{
+	{Year: 1696, Month: 9, Day: 1, HasTimezone: true},
+	{Year: 1697, Month: 2, Day: 1, HasTimezone: true},
+	{Year: 1903, Month: 3, Day: 1, HasTimezone: true},
+	{Year: 1903, Month: 7, Day: 1, HasTimezone: true},
+}
+
+//	Compares two (normalized, lexically valid) values of the base type in its value space. ErrIndeterminate is
+//	returned for incomparable values, such as NaN or two durations like P1M and P30D.
+func (me builtinBase) compare(a, b string) (int, error) {
+	switch me.kind {
+	case kindDecimal:
+		var x, y Decimal
+		if err := x.Parse(a); err != nil {
+			return 0, err
+		}
+		if err := y.Parse(b); err != nil {
+			return 0, err
+		}
+		return x.Cmp(y), nil
+	case kindFloat:
+		//	ParseFloat accepts the XSD spellings INF, -INF and NaN too.
+		x, _ := strconv.ParseFloat(a, 64)
+		y, _ := strconv.ParseFloat(b, 64)
+		switch {
+		case math.IsNaN(x) || math.IsNaN(y):
+			return 0, ErrIndeterminate
+		case x < y:
+			return -1, nil
+		case x > y:
+			return 1, nil
+		}
+		return 0, nil
+	case kindDateTime:
+		x, err := parseDateTimeValue(me.dt, a)
+		if err != nil {
+			return 0, err
+		}
+		y, err := parseDateTimeValue(me.dt, b)
+		if err != nil {
+			return 0, err
+		}
+		return x.Compare(y)
+	case kindDuration:
+		x, err := parseDurationValue(a)
+		if err != nil {
+			return 0, err
+		}
+		y, err := parseDurationValue(b)
+		if err != nil {
+			return 0, err
+		}
+		res := 0
+		for i, ref := range durationReferences {
+			c, _ := x.AddTo(ref).Compare(y.AddTo(ref))
+			if i > 0 && c != res {
+				return 0, ErrIndeterminate
+			}
+			res = c
+		}
+		return res, nil
+	}
+	if a == b {
+		return 0, nil
+	}
+	return 0, ErrIndeterminate
+}
+
+//	Returns the length of a (normalized, lexically valid) value as the length facets measure it: characters for
+//	strings, octets for binary types and items for list types.
+func (me builtinBase) length(s string) int {
+	switch me.kind {
+	case kindList:
+		return len(strings.FieldsFunc(s, isXsdWhitespace))
+	case kindHexBinary:
+		return len(s) / 2
+	case kindBase64Binary:
+		b, _ := Base64Binary(s).Bytes()
+		return len(b)
+	}
+	return utf8.RuneCountInString(s)
+}
+
+//	Splits a decimal lexical value into its significant integer and fraction digits.
+func decimalDigits(s string) (intDigits, fracDigits string) {
+	intDigits, fracDigits, _ = strings.Cut(strings.TrimLeft(s, "+-"), ".")
+	return strings.TrimLeft(intDigits, "0"), strings.TrimRight(fracDigits, "0")
+}
+
+//	A constraining facet, created by one of Pattern, Enumeration, Length, MinLength, MaxLength, MinInclusive,
+//	MaxInclusive, MinExclusive, MaxExclusive, TotalDigits or FractionDigits and attached to a Restriction.
+type Facet interface {
+	//	Returns the facet's name as written in a schema, e.g. "maxLength".
+	Name() string
+
+	//	Returns the facet's value as written in a schema, e.g. "8".
+	String() string
+
+	//	Checks that the facet applies to the base type of r and that its value is valid for it, and returns the
+	//	facet prepared for checking values of r. The receiver is left alone, so facets can be shared.
+	init(r *Restriction) (Facet, error)
+
+	//	Checks the normalized value v, which is known to be lexically valid for the base type of r.
+	check(r *Restriction, v string) *FacetError
+}
+
+type patternFacet struct {
+	exprs []string
+	res   []*Regexp
+}
+
+//	Returns a pattern facet: values must match one of the specified XSD regular expressions (see CompileRegexp)
+//	in full. Several expressions given here (or in several Pattern facets of the same Restriction) are
+//	alternatives, as in a schema; patterns of a base restriction must be matched as well.
+func Pattern(exprs ...string) Facet {
+	return &patternFacet{exprs: append([]string(nil), exprs...)}
+}
+
+func (me *patternFacet) Name() string   { return "pattern" }
+func (me *patternFacet) String() string { return strings.Join(me.exprs, "|") }
+
+func (me *patternFacet) init(r *Restriction) (Facet, error) {
+	f := &patternFacet{exprs: me.exprs, res: make([]*Regexp, len(me.exprs))}
+	for i, expr := range me.exprs {
+		re, err := CompileRegexp(expr)
+		if err != nil {
+			return nil, err
+		}
+		f.res[i] = re
+	}
+	return f, nil
+}
+
+func (me *patternFacet) check(r *Restriction, v string) *FacetError {
+	for _, re := range me.res {
+		if re.MatchString(v) {
+			return nil
+		}
+	}
+	return &FacetError{Facet: "pattern", Constraint: me.String()}
+}
+
+type enumerationFacet struct {
+	values []string
+}
+
+//	Returns an enumeration facet: values must equal one of the specified values in the value space of the base
+//	type, so that "1.0" matches "1" for a decimal base.
+func Enumeration(values ...string) Facet {
+	return &enumerationFacet{values: append([]string(nil), values...)}
+}
+
+func (me *enumerationFacet) Name() string   { return "enumeration" }
+func (me *enumerationFacet) String() string { return strings.Join(me.values, "|") }
+
+func (me *enumerationFacet) init(r *Restriction) (Facet, error) {
+	f := &enumerationFacet{values: make([]string, len(me.values))}
+	for i, v := range me.values {
+		v = r.base.ws.Apply(v)
+		if r.base.check != nil {
+			if err := r.base.check(v); err != nil {
+				return nil, err
+			}
+		}
+		f.values[i] = v
+	}
+	return f, nil
+}
+
+func (me *enumerationFacet) check(r *Restriction, v string) *FacetError {
+	for _, allowed := range me.values {
+		if c, err := r.base.compare(v, allowed); err == nil && c == 0 {
+			return nil
+		}
+	}
+	return &FacetError{Facet: "enumeration", Constraint: me.String()}
+}
+
+type lengthFacet struct {
+	name     string
+	n        int
+	min, max bool
+}
+
+//	Returns a length facet: values must have exactly n characters, octets (for binary types) or items (for
+//	list types).
+func Length(n int) Facet {
+	return &lengthFacet{name: "length", n: n, min: true, max: true}
+}
+
+//	Returns a minLength facet: values must have at least n characters, octets or items (see Length).
+func MinLength(n int) Facet {
+	return &lengthFacet{name: "minLength", n: n, min: true}
+}
+
+//	Returns a maxLength facet: values must have at most n characters, octets or items (see Length).
+func MaxLength(n int) Facet {
+	return &lengthFacet{name: "maxLength", n: n, max: true}
+}
+
+func (me *lengthFacet) Name() string   { return me.name }
+func (me *lengthFacet) String() string { return strconv.Itoa(me.n) }
+
+func (me *lengthFacet) init(r *Restriction) (Facet, error) {
+	switch r.base.kind {
+	case kindString, kindList, kindHexBinary, kindBase64Binary, kindQName:
+	default:
+		return nil, fmt.Errorf("%s facet does not apply to xs:%s", me.name, r.Base)
+	}
+	if me.n < 0 {
+		return nil, fmt.Errorf("%s facet must not be negative", me.name)
+	}
+	return me, nil
+}
+
+func (me *lengthFacet) check(r *Restriction, v string) *FacetError {
+	if r.base.kind == kindQName {
+		//	As of XSD 1.1, length facets are ignored for QName and NOTATION.
+		return nil
+	}
+	if n := r.base.length(v); (me.min && n < me.n) || (me.max && n > me.n) {
+		return &FacetError{Facet: me.name, Constraint: me.String(), Detail: "length " + strconv.Itoa(n)}
+	}
+	return nil
+}
+
+type boundFacet struct {
+	name      string
+	value     string
+	sign      int
+	inclusive bool
+}
+
+//	Returns a minInclusive facet: values must not be less than v, in the value space of the base type.
+func MinInclusive(v string) Facet {
+	return &boundFacet{name: "minInclusive", value: v, sign: 1, inclusive: true}
+}
+
+//	Returns a maxInclusive facet: values must not be greater than v, in the value space of the base type.
+func MaxInclusive(v string) Facet {
+	return &boundFacet{name: "maxInclusive", value: v, sign: -1, inclusive: true}
+}
+
+//	Returns a minExclusive facet: values must be greater than v, in the value space of the base type.
+func MinExclusive(v string) Facet {
+	return &boundFacet{name: "minExclusive", value: v, sign: 1}
+}
+
+//	Returns a maxExclusive facet: values must be less than v, in the value space of the base type.
+func MaxExclusive(v string) Facet {
+	return &boundFacet{name: "maxExclusive", value: v, sign: -1}
+}
+
+func (me *boundFacet) Name() string   { return me.name }
+func (me *boundFacet) String() string { return me.value }
+
+func (me *boundFacet) init(r *Restriction) (Facet, error) {
+	switch r.base.kind {
+	case kindDecimal, kindFloat, kindDuration, kindDateTime:
+	default:
+		return nil, fmt.Errorf("%s facet does not apply to unordered type xs:%s", me.name, r.Base)
+	}
+	f := *me
+	f.value = r.base.ws.Apply(me.value)
+	if err := r.base.check(f.value); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+func (me *boundFacet) check(r *Restriction, v string) *FacetError {
+	c, err := r.base.compare(v, me.value)
+	if err == nil && (c == me.sign || (c == 0 && me.inclusive)) {
+		return nil
+	}
+	fe := &FacetError{Facet: me.name, Constraint: me.value}
+	if errors.Is(err, ErrIndeterminate) {
+		fe.Detail = "order is indeterminate"
+	}
+	return fe
+}
+
+type digitsFacet struct {
+	name string
+	n    int
+}
+
+//	Returns a totalDigits facet: decimal values must have at most n significant digits.
+func TotalDigits(n int) Facet {
+	return &digitsFacet{name: "totalDigits", n: n}
+}
+
+//	Returns a fractionDigits facet: decimal values must have at most n significant digits after the decimal point.
+func FractionDigits(n int) Facet {
+	return &digitsFacet{name: "fractionDigits", n: n}
+}
+
+func (me *digitsFacet) Name() string   { return me.name }
+func (me *digitsFacet) String() string { return strconv.Itoa(me.n) }
+
+func (me *digitsFacet) init(r *Restriction) (Facet, error) {
+	if r.base.kind != kindDecimal {
+		return nil, fmt.Errorf("%s facet does not apply to xs:%s", me.name, r.Base)
+	}
+	if me.n < 0 || (me.n == 0 && me.name == "totalDigits") {
+		return nil, fmt.Errorf("%s facet out of range: %d", me.name, me.n)
+	}
+	return me, nil
+}
+
+func (me *digitsFacet) check(r *Restriction, v string) *FacetError {
+	intDigits, fracDigits := decimalDigits(v)
+	n := len(fracDigits)
+	if me.name == "totalDigits" {
+		n += len(intDigits)
+	}
+	if n > me.n {
+		return &FacetError{Facet: me.name, Constraint: me.String(), Detail: strconv.Itoa(n) + " digits"}
+	}
+	return nil
+}
+
+//	A named simple type derived by restriction from a built-in type (or from another Restriction) by a set of
+//	constraining facets. A value is valid if it is lexically valid for the built-in type and satisfies the facets
+//	of the Restriction and of all the restrictions it derives from.
+type Restriction struct {
+	//	The name of the restricted type, used in errors.
+	Name string
+
+	//	The XSD name of the built-in type this derives from, e.g. "string" or "positiveInteger".
+	Base string
+
+	base   builtinBase
+	parent *Restriction
+	facets []Facet
+}
+
+//	Returns a new Restriction of the built-in type named base (e.g. "string", "decimal" or "dateTime") by the
+//	specified facets, or an error if base is unknown or a facet does not apply to it.
+func NewRestriction(name, base string, facets ...Facet) (*Restriction, error) {
+	b, ok := builtinBases[base]
+	if !ok {
+		return nil, fmt.Errorf("xsdt: %s: unknown base type xs:%s", name, base)
+	}
+	return newRestriction(name, base, b, nil, facets)
+}
+
+//	Like NewRestriction, but panics on error. Meant for package-level variables.
+func MustRestriction(name, base string, facets ...Facet) *Restriction {
+	r, err := NewRestriction(name, base, facets...)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func newRestriction(name, base string, b builtinBase, parent *Restriction, facets []Facet) (*Restriction, error) {
+	r := &Restriction{Name: name, Base: base, base: b, parent: parent}
+	var patterns []string
+	for _, f := range facets {
+		if p, ok := f.(*patternFacet); ok {
+			patterns = append(patterns, p.exprs...)
+			continue
+		}
+		bound, err := f.init(r)
+		if err != nil {
+			return nil, fmt.Errorf("xsdt: %s: %s facet: %w", name, f.Name(), err)
+		}
+		r.facets = append(r.facets, bound)
+	}
+	if len(patterns) > 0 {
+		p, err := Pattern(patterns...).init(r)
+		if err != nil {
+			return nil, fmt.Errorf("xsdt: %s: pattern facet: %w", name, err)
+		}
+		r.facets = append(r.facets, p)
+	}
+	return r, nil
+}
+
+//	Returns a new Restriction that further restricts this one by the specified facets.
+func (me *Restriction) Restrict(name string, facets ...Facet) (*Restriction, error) {
+	return newRestriction(name, me.Base, me.base, me, facets)
+}
+
+//	Returns the facets of this Restriction, not including those of the restrictions it derives from.
+func (me *Restriction) Facets() []Facet {
+	return append([]Facet(nil), me.facets...)
+}
+
+//	Returns the values allowed by the nearest enumeration facet, or nil if there is none.
+func (me *Restriction) Enumeration() []string {
+	for r := me; r != nil; r = r.parent {
+		for _, f := range r.facets {
+			if e, ok := f.(*enumerationFacet); ok {
+				return append([]string(nil), e.values...)
+			}
+		}
+	}
+	return nil
+}
+
+//	Applies the whiteSpace facet of the base type to s.
+func (me *Restriction) Normalize(s string) string {
+	return me.base.ws.Apply(s)
+}
+
+//	Checks s against the base type and all facets. The error is the base type's ParseError or ValidationError
+//	if s is not even lexically valid, or else a FacetError naming the first facet that failed.
+func (me *Restriction) Validate(s string) error {
+	v := me.Normalize(s)
+	if me.base.check != nil {
+		if err := me.base.check(v); err != nil {
+			return err
+		}
+	}
+	return me.validate(v)
+}
+
+func (me *Restriction) validate(v string) error {
+	if me.parent != nil {
+		if err := me.parent.validate(v); err != nil {
+			return err
+		}
+	}
+	for _, f := range me.facets {
+		if fe := f.check(me, v); fe != nil {
+			fe.Type, fe.Value = me.Name, v
+			return fe
+		}
+	}
+	return nil
+}
+
+//	Reports whether Validate succeeds.
+func (me *Restriction) IsValid(s string) bool {
+	return me.Validate(s) == nil
+}
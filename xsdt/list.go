@@ -0,0 +1,150 @@
+package xsdt
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+//	A slice-backed XSD list of values of the string-based item type T, written as a whitespace-separated string.
+//	Unlike the string-backed list types (Idrefs, Nmtokens, Entities), its items can be inspected and changed in
+//	place. Items must not contain whitespace themselves, since that separates them in the lexical form.
+//	User-defined list types can be declared as e.g. type RoleList = xsdt.List[RoleType].
+type List[T ~string] []T
+
+//	A list of IDREF values, as used by the METS ADMID and DMDID attributes.
+type IdrefList = List[Idref]
+
+//	A list of NMTOKEN values.
+type NmtokenList = List[Nmtoken]
+
+//	A list of ENTITY values.
+type EntityList = List[Entity]
+
+//	Returns the list whose items are the whitespace-separated values in s.
+func ListOf[T ~string](s string) (list List[T]) {
+	list.Set(s)
+	return
+}
+
+//	Sets the current items from the specified whitespace-separated string.
+func (me *List[T]) Set(s string) {
+	fields := strings.FieldsFunc(s, isXsdWhitespace)
+	list := make(List[T], len(fields))
+	for i, f := range fields {
+		list[i] = T(f)
+	}
+	if len(list) == 0 {
+		list = nil
+	}
+	*me = list
+}
+
+//	Returns the lexical form of the list: its items separated by single spaces.
+func (me List[T]) String() string {
+	var b strings.Builder
+	for i, v := range me {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(string(v))
+	}
+	return b.String()
+}
+
+//	Reports whether the list holds v.
+func (me List[T]) Contains(v T) bool {
+	return me.Index(v) >= 0
+}
+
+//	Returns the position of the first occurrence of v in the list, or -1.
+func (me List[T]) Index(v T) int {
+	for i, item := range me {
+		if item == v {
+			return i
+		}
+	}
+	return -1
+}
+
+//	Appends the specified items to the list.
+func (me *List[T]) Append(v ...T) {
+	*me = append(*me, v...)
+}
+
+//	Appends those of the specified items that the list does not already hold.
+func (me *List[T]) AppendUnique(v ...T) {
+	for _, item := range v {
+		if !me.Contains(item) {
+			*me = append(*me, item)
+		}
+	}
+}
+
+//	Removes all occurrences of v from the list, and reports whether there were any.
+func (me *List[T]) Remove(v T) (removed bool) {
+	list := (*me)[:0]
+	for _, item := range *me {
+		if item == v {
+			removed = true
+		} else {
+			list = append(list, item)
+		}
+	}
+	if len(list) == 0 {
+		list = nil
+	}
+	*me = list
+	return
+}
+
+//	Validates every item whose type has a Validate method (see e.g. Idref.Validate), returning the first error.
+//	An item containing whitespace is reported as a ValidationError, as it would not survive a round trip.
+func (me List[T]) Validate() error {
+	for _, item := range me {
+		if strings.IndexFunc(string(item), isXsdWhitespace) >= 0 {
+			return &ValidationError{Type: "list", Value: string(item), Rule: "list item without whitespace", Offset: strings.IndexFunc(string(item), isXsdWhitespace)}
+		}
+		if v, ok := any(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+//	Implements encoding.TextMarshaler.
+func (me List[T]) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *List[T]) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me List[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *List[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Returns the current value as a slice-backed list.
+func (me Idrefs) List() IdrefList {
+	return ListOf[Idref](string(me))
+}
+
+//	Returns the current value as a slice-backed list.
+func (me Nmtokens) List() NmtokenList {
+	return ListOf[Nmtoken](string(me))
+}
+
+//	Returns the current value as a slice-backed list.
+func (me Entities) List() EntityList {
+	return ListOf[Entity](string(me))
+}
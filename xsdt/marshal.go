@@ -0,0 +1,939 @@
+package xsdt
+
+import (
+	"encoding"
+	"encoding/xml"
+)
+
+//	All types in this package implement encoding.TextMarshaler, encoding.TextUnmarshaler, xml.MarshalerAttr and
+//	xml.UnmarshalerAttr, so that encoding/xml (and forks of it that honour the encoding interfaces) apply the XSD
+//	lexical rules when reading documents, and write the same lexical forms String returns.
+
+func marshalAttr(name xml.Name, value encoding.TextMarshaler) (xml.Attr, error) {
+	text, err := value.MarshalText()
+	return xml.Attr{Name: name, Value: string(text)}, err
+}
+
+//	Implements encoding.TextMarshaler.
+func (me AnySimpleType) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *AnySimpleType) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me AnySimpleType) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *AnySimpleType) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me AnyType) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *AnyType) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me AnyType) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *AnyType) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me AnyURI) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *AnyURI) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me AnyURI) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *AnyURI) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Base64Binary) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Base64Binary) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Boolean) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *Boolean) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Boolean) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Boolean) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Byte) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *Byte) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Byte) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Byte) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Date) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *Date) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Date) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me DateTime) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *DateTime) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me DateTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *DateTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Decimal) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *Decimal) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr. A Decimal without value is left out.
+func (me Decimal) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if me.IsZero() {
+		return xml.Attr{}, nil
+	}
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Decimal) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Double) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *Double) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Double) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Double) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Duration) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *Duration) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Duration) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Duration) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Entities) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *Entities) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Entities) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Entities) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Entity) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *Entity) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Entity) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Entity) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Float) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *Float) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Float) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Float) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me GDay) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *GDay) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me GDay) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *GDay) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me GMonth) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *GMonth) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me GMonth) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *GMonth) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me GMonthDay) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *GMonthDay) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me GMonthDay) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *GMonthDay) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me GYear) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *GYear) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me GYear) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *GYear) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me GYearMonth) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *GYearMonth) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me GYearMonth) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *GYearMonth) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me HexBinary) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *HexBinary) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Id) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *Id) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Id) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Id) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Idref) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *Idref) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Idref) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Idref) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Idrefs) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *Idrefs) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Idrefs) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Idrefs) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Int) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *Int) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Int) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Int) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Integer) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *Integer) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr. A Integer without value is left out.
+func (me Integer) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if me.IsZero() {
+		return xml.Attr{}, nil
+	}
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Integer) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Language) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *Language) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Language) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Language) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Long) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *Long) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Long) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Long) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Name) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *Name) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Name) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Name) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me NCName) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *NCName) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me NCName) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *NCName) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me NegativeInteger) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *NegativeInteger) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me NegativeInteger) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *NegativeInteger) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Nmtoken) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *Nmtoken) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Nmtoken) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Nmtoken) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Nmtokens) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *Nmtokens) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Nmtokens) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Nmtokens) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me NonNegativeInteger) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *NonNegativeInteger) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me NonNegativeInteger) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *NonNegativeInteger) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me NonPositiveInteger) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *NonPositiveInteger) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me NonPositiveInteger) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *NonPositiveInteger) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me NormalizedString) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *NormalizedString) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me NormalizedString) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *NormalizedString) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Notation) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *Notation) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Notation) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Notation) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me PositiveInteger) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *PositiveInteger) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me PositiveInteger) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *PositiveInteger) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Qname) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *Qname) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Qname) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Qname) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Short) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *Short) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Short) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Short) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me String) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *String) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me String) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *String) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Time) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *Time) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me Token) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler.
+func (me *Token) UnmarshalText(text []byte) error {
+	me.Set(string(text))
+	return nil
+}
+
+//	Implements xml.MarshalerAttr.
+func (me Token) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *Token) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me UnsignedByte) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *UnsignedByte) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me UnsignedByte) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *UnsignedByte) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me UnsignedInt) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *UnsignedInt) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me UnsignedInt) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *UnsignedInt) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me UnsignedLong) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *UnsignedLong) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me UnsignedLong) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *UnsignedLong) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
+
+//	Implements encoding.TextMarshaler.
+func (me UnsignedShort) MarshalText() ([]byte, error) {
+	return []byte(me.String()), nil
+}
+
+//	Implements encoding.TextUnmarshaler, rejecting values that Parse rejects.
+func (me *UnsignedShort) UnmarshalText(text []byte) error {
+	return me.Parse(string(text))
+}
+
+//	Implements xml.MarshalerAttr.
+func (me UnsignedShort) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalAttr(name, me)
+}
+
+//	Implements xml.UnmarshalerAttr.
+func (me *UnsignedShort) UnmarshalXMLAttr(attr xml.Attr) error {
+	return me.UnmarshalText([]byte(attr.Value))
+}
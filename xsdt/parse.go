@@ -0,0 +1,204 @@
+package xsdt
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	//	Reported (wrapped in a ParseError) when a string is not in the lexical space of a type.
+	ErrSyntax = errors.New("invalid lexical form")
+
+	//	Reported (wrapped in a ParseError) when a lexically valid string maps to a value outside the value space of a type.
+	ErrRange = errors.New("value out of range")
+)
+
+//	Returned by the Parse methods when a string cannot be mapped to a value of an XSD built-in type.
+type ParseError struct {
+	//	The XSD name of the type being parsed, e.g. "positiveInteger".
+	Type string
+
+	//	The string that failed to parse.
+	Value string
+
+	//	Either ErrSyntax, ErrRange or a more specific cause.
+	Err error
+}
+
+func (me *ParseError) Error() string {
+	return "xsdt: parsing " + strconv.Quote(me.Value) + " as xs:" + me.Type + ": " + me.Err.Error()
+}
+
+//	Returns the underlying cause, so that errors.Is(err, ErrRange) etc. work as expected.
+func (me *ParseError) Unwrap() error {
+	return me.Err
+}
+
+func isXsdWhitespace(r rune) bool {
+	return (r == ' ') || (r == '\r') || (r == '\n') || (r == '\t')
+}
+
+//	Strips leading and trailing XSD whitespace, as the whiteSpace="collapse" facet of all non-string built-in types demands.
+func trimXsdWhitespace(s string) string {
+	return strings.TrimFunc(s, isXsdWhitespace)
+}
+
+//	Checks s against the integer lexical space [\-+]?[0-9]+ and returns the sign and the digits.
+func splitIntegerLexical(s string) (neg bool, digits string, ok bool) {
+	digits = s
+	if len(digits) > 0 && (digits[0] == '+' || digits[0] == '-') {
+		neg, digits = digits[0] == '-', digits[1:]
+	}
+	if len(digits) == 0 {
+		return
+	}
+	for i := 0; i < len(digits); i++ {
+		if digits[i] < '0' || digits[i] > '9' {
+			return
+		}
+	}
+	ok = true
+	return
+}
+
+func isAllZeros(digits string) bool {
+	return strings.Trim(digits, "0") == ""
+}
+
+//	Parses an xsd:integer-derived lexical value into an int64, rejecting anything outside [min, max].
+func parseSigned(typ, s string, bitSize int, min, max int64) (int64, error) {
+	v := trimXsdWhitespace(s)
+	neg, digits, ok := splitIntegerLexical(v)
+	if !ok {
+		return 0, &ParseError{Type: typ, Value: s, Err: ErrSyntax}
+	}
+	if neg {
+		digits = "-" + digits
+	}
+	n, err := strconv.ParseInt(digits, 10, bitSize)
+	if err != nil || n < min || n > max {
+		return 0, &ParseError{Type: typ, Value: s, Err: ErrRange}
+	}
+	return n, nil
+}
+
+//	Parses an xsd:nonNegativeInteger-derived lexical value into a uint64, rejecting anything below min.
+//	A minus sign is only permitted on zero, as in "-0".
+func parseUnsigned(typ, s string, bitSize int, min uint64) (uint64, error) {
+	v := trimXsdWhitespace(s)
+	neg, digits, ok := splitIntegerLexical(v)
+	if !ok {
+		return 0, &ParseError{Type: typ, Value: s, Err: ErrSyntax}
+	}
+	if neg && !isAllZeros(digits) {
+		return 0, &ParseError{Type: typ, Value: s, Err: ErrRange}
+	}
+	n, err := strconv.ParseUint(digits, 10, bitSize)
+	if err != nil || n < min {
+		return 0, &ParseError{Type: typ, Value: s, Err: ErrRange}
+	}
+	return n, nil
+}
+
+//	Checks s against the xsd:decimal lexical space (\+|-)?([0-9]+(\.[0-9]*)?|\.[0-9]+).
+func isDecimalLexical(s string) bool {
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	intPart, fracPart, hasDot := strings.Cut(s, ".")
+	if len(intPart) == 0 && len(fracPart) == 0 {
+		return false
+	}
+	if !hasDot && len(intPart) == 0 {
+		return false
+	}
+	for _, part := range []string{intPart, fracPart} {
+		for i := 0; i < len(part); i++ {
+			if part[i] < '0' || part[i] > '9' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+//	Checks s against the xsd:double lexical space, which (unlike strconv.ParseFloat) admits neither
+//	hexadecimal mantissas, underscores nor spellings such as "Inf" or "infinity".
+func isFloatLexical(s string) bool {
+	switch s {
+	case "INF", "+INF", "-INF", "NaN":
+		return true
+	}
+	mant, exp := s, ""
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		mant, exp = s[:i], s[i+1:]
+		if _, _, ok := splitIntegerLexical(exp); !ok {
+			return false
+		}
+	}
+	return isDecimalLexical(mant)
+}
+
+//	Parses an xsd:float or xsd:double lexical value. Values too large in magnitude for bitSize are reported as ErrRange.
+func parseFloat(typ, s string, bitSize int) (float64, error) {
+	v := trimXsdWhitespace(s)
+	if !isFloatLexical(v) {
+		return 0, &ParseError{Type: typ, Value: s, Err: ErrSyntax}
+	}
+	switch v {
+	case "INF", "+INF":
+		return strconv.ParseFloat("+Inf", bitSize)
+	case "-INF":
+		return strconv.ParseFloat("-Inf", bitSize)
+	case "NaN":
+		return strconv.ParseFloat("NaN", bitSize)
+	}
+	f, err := strconv.ParseFloat(v, bitSize)
+	if err != nil {
+		return 0, &ParseError{Type: typ, Value: s, Err: ErrRange}
+	}
+	return f, nil
+}
+
+//	Like parseFloat, but never fails: values too large in magnitude round to INF or -INF, as XSD 1.1 prescribes,
+//	and invalid strings yield zero.
+func setFloat(typ, s string, bitSize int) float64 {
+	f, err := parseFloat(typ, s, bitSize)
+	if errors.Is(err, ErrRange) {
+		f, _ = strconv.ParseFloat(trimXsdWhitespace(s), bitSize)
+	}
+	return f
+}
+
+//	Returns the canonical xsd:float or xsd:double representation of f: "INF", "-INF" or "NaN" for the special
+//	values, and otherwise the shortest decimal mantissa that reads back as f, with exactly one non-zero digit
+//	before the point and at least one after it, and an exponent without "+" or leading zeros, e.g. "1.0E6" or
+//	"-1.25E-3". Zero keeps its sign: "0.0E0" or "-0.0E0".
+func formatFloat(f float64, bitSize int) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "INF"
+	case math.IsInf(f, -1):
+		return "-INF"
+	case f == 0 && math.Signbit(f):
+		return "-0.0E0"
+	case f == 0:
+		return "0.0E0"
+	}
+	mant, exp, _ := strings.Cut(strconv.FormatFloat(f, 'E', -1, bitSize), "E")
+	if !strings.Contains(mant, ".") {
+		mant += ".0"
+	}
+	neg := strings.HasPrefix(exp, "-")
+	exp = strings.TrimLeft(exp, "+-0")
+	if exp == "" {
+		return mant + "E0"
+	} else if neg {
+		exp = "-" + exp
+	}
+	return mant + "E" + exp
+}
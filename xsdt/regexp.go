@@ -0,0 +1,622 @@
+package xsdt
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+//	Returned by CompileRegexp when an expression is not a valid XSD regular expression.
+type RegexpError struct {
+	//	The expression being compiled.
+	Expr string
+
+	//	Byte offset into Expr at which the problem was detected.
+	Offset int
+
+	//	What is wrong, e.g. "missing ]".
+	Msg string
+}
+
+func (me *RegexpError) Error() string {
+	return "xsdt: invalid XSD pattern " + strconv.Quote(me.Expr) + " at offset " + strconv.Itoa(me.Offset) + ": " + me.Msg
+}
+
+//	A compiled XSD 1.0 regular expression (XML Schema Part 2, Appendix F), as used by pattern facets. It is
+//	translated to an equivalent Go regexp: XSD patterns always match the whole value, "^" and "$" are ordinary
+//	characters, and the dialect adds the \i and \c name character escapes, \p{IsBlock} Unicode block escapes and
+//	character class subtraction such as [a-z-[aeiou]].
+type Regexp struct {
+	expr string
+	re   *regexp.Regexp
+}
+
+//	Compiles an XSD regular expression.
+func CompileRegexp(expr string) (*Regexp, error) {
+	p := &reParser{expr: expr}
+	goExpr, err := p.regExp()
+	if err == nil && p.pos < len(expr) {
+		err = p.fail("unmatched )")
+	}
+	if err != nil {
+		return nil, err
+	}
+	re, rerr := regexp.Compile(`^(?:` + goExpr + `)$`)
+	if rerr != nil {
+		return nil, &RegexpError{Expr: expr, Offset: 0, Msg: rerr.Error()}
+	}
+	return &Regexp{expr: expr, re: re}, nil
+}
+
+//	Like CompileRegexp, but panics on error. Meant for package-level variables.
+func MustCompileRegexp(expr string) *Regexp {
+	re, err := CompileRegexp(expr)
+	if err != nil {
+		panic(err)
+	}
+	return re
+}
+
+//	Reports whether s matches the expression in full.
+func (me *Regexp) MatchString(s string) bool {
+	return me.re.MatchString(s)
+}
+
+//	Returns the XSD expression the Regexp was compiled from.
+func (me *Regexp) String() string {
+	return me.expr
+}
+
+//	Returns the Go regexp the expression was translated to, which matches exactly the same strings.
+func (me *Regexp) GoRegexp() *regexp.Regexp {
+	return me.re
+}
+
+//	A set of runes as sorted, non-overlapping, non-adjacent inclusive ranges.
+type runeSet [][2]rune
+
+const maxSetRune = unicode.MaxRune
+
+//	Surrogates never occur in Go strings, so they are left out of every set.
+var surrogates = runeSet{{0xD800, 0xDFFF}}
+
+func (me runeSet) normalize() runeSet {
+	sort.Slice(me, func(i, j int) bool { return me[i][0] < me[j][0] })
+	out := me[:0]
+	for _, r := range me {
+		if n := len(out); n > 0 && r[0] <= out[n-1][1]+1 {
+			if r[1] > out[n-1][1] {
+				out[n-1][1] = r[1]
+			}
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+func (me runeSet) union(other runeSet) runeSet {
+	return append(append(runeSet(nil), me...), other...).normalize()
+}
+
+func (me runeSet) negate() runeSet {
+	var out runeSet
+	next := rune(0)
+	for _, r := range me {
+		if r[0] > next {
+			out = append(out, [2]rune{next, r[0] - 1})
+		}
+		next = r[1] + 1
+	}
+	if next <= maxSetRune {
+		out = append(out, [2]rune{next, maxSetRune})
+	}
+	return out
+}
+
+func (me runeSet) subtract(other runeSet) runeSet {
+	return me.negate().union(other).negate()
+}
+
+//	Returns the set as Go regexp syntax.
+func (me runeSet) goSyntax() string {
+	me = me.subtract(surrogates)
+	if len(me) == 0 {
+		return `[^\x{0}-\x{10FFFF}]`
+	}
+	if len(me) == 1 && me[0][0] == me[0][1] {
+		return regexp.QuoteMeta(string(me[0][0]))
+	}
+	var b strings.Builder
+	b.WriteByte('[')
+	for _, r := range me {
+		b.WriteString(`\x{` + strconv.FormatInt(int64(r[0]), 16) + `}`)
+		if r[1] != r[0] {
+			b.WriteString(`-\x{` + strconv.FormatInt(int64(r[1]), 16) + `}`)
+		}
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+func tableSet(tables ...*unicode.RangeTable) (set runeSet) {
+	for _, t := range tables {
+		for _, r := range t.R16 {
+			for c := rune(r.Lo); c <= rune(r.Hi); c += rune(r.Stride) {
+				if r.Stride == 1 {
+					set = append(set, [2]rune{c, rune(r.Hi)})
+					break
+				}
+				set = append(set, [2]rune{c, c})
+			}
+		}
+		for _, r := range t.R32 {
+			for c := rune(r.Lo); c <= rune(r.Hi); c += rune(r.Stride) {
+				if r.Stride == 1 {
+					set = append(set, [2]rune{c, rune(r.Hi)})
+					break
+				}
+				set = append(set, [2]rune{c, c})
+			}
+		}
+	}
+	return set.normalize()
+}
+
+//	The ranges of XML 1.0 (Fifth Edition) NameStartChar, as tested by IsNameStartChar.
+var nameStartSet = runeSet{{':', ':'}, {'A', 'Z'}, {'_', '_'}, {'a', 'z'}, {0xC0, 0xD6}, {0xD8, 0xF6},
+	{0xF8, 0x2FF}, {0x370, 0x37D}, {0x37F, 0x1FFF}, {0x200C, 0x200D}, {0x2070, 0x218F}, {0x2C00, 0x2FEF},
+	{0x3001, 0xD7FF}, {0xF900, 0xFDCF}, {0xFDF0, 0xFFFD}, {0x10000, 0xEFFFF}}.normalize()
+
+//	The ranges of XML 1.0 (Fifth Edition) NameChar, as tested by IsNameChar.
+var nameSet = nameStartSet.union(runeSet{{'-', '-'}, {'.', '.'}, {'0', '9'}, {0xB7, 0xB7}, {0x300, 0x36F}, {0x203F, 0x2040}})
+
+//	The Unicode 3.1 blocks that XSD 1.0 defines \p{IsBlock} escapes for. Some names cover several ranges.
+var unicodeBlocks = map[string]runeSet{
+	"BasicLatin":                           {{0x0000, 0x007F}},
+	"Latin-1Supplement":                    {{0x0080, 0x00FF}},
+	"LatinExtended-A":                      {{0x0100, 0x017F}},
+	"LatinExtended-B":                      {{0x0180, 0x024F}},
+	"IPAExtensions":                        {{0x0250, 0x02AF}},
+	"SpacingModifierLetters":               {{0x02B0, 0x02FF}},
+	"CombiningDiacriticalMarks":            {{0x0300, 0x036F}},
+	"Greek":                                {{0x0370, 0x03FF}},
+	"Cyrillic":                             {{0x0400, 0x04FF}},
+	"Armenian":                             {{0x0530, 0x058F}},
+	"Hebrew":                               {{0x0590, 0x05FF}},
+	"Arabic":                               {{0x0600, 0x06FF}},
+	"Syriac":                               {{0x0700, 0x074F}},
+	"Thaana":                               {{0x0780, 0x07BF}},
+	"Devanagari":                           {{0x0900, 0x097F}},
+	"Bengali":                              {{0x0980, 0x09FF}},
+	"Gurmukhi":                             {{0x0A00, 0x0A7F}},
+	"Gujarati":                             {{0x0A80, 0x0AFF}},
+	"Oriya":                                {{0x0B00, 0x0B7F}},
+	"Tamil":                                {{0x0B80, 0x0BFF}},
+	"Telugu":                               {{0x0C00, 0x0C7F}},
+	"Kannada":                              {{0x0C80, 0x0CFF}},
+	"Malayalam":                            {{0x0D00, 0x0D7F}},
+	"Sinhala":                              {{0x0D80, 0x0DFF}},
+	"Thai":                                 {{0x0E00, 0x0E7F}},
+	"Lao":                                  {{0x0E80, 0x0EFF}},
+	"Tibetan":                              {{0x0F00, 0x0FFF}},
+	"Myanmar":                              {{0x1000, 0x109F}},
+	"Georgian":                             {{0x10A0, 0x10FF}},
+	"HangulJamo":                           {{0x1100, 0x11FF}},
+	"Ethiopic":                             {{0x1200, 0x137F}},
+	"Cherokee":                             {{0x13A0, 0x13FF}},
+	"UnifiedCanadianAboriginalSyllabics":   {{0x1400, 0x167F}},
+	"Ogham":                                {{0x1680, 0x169F}},
+	"Runic":                                {{0x16A0, 0x16FF}},
+	"Khmer":                                {{0x1780, 0x17FF}},
+	"Mongolian":                            {{0x1800, 0x18AF}},
+	"LatinExtendedAdditional":              {{0x1E00, 0x1EFF}},
+	"GreekExtended":                        {{0x1F00, 0x1FFF}},
+	"GeneralPunctuation":                   {{0x2000, 0x206F}},
+	"SuperscriptsandSubscripts":            {{0x2070, 0x209F}},
+	"CurrencySymbols":                      {{0x20A0, 0x20CF}},
+	"CombiningMarksforSymbols":             {{0x20D0, 0x20FF}},
+	"LetterlikeSymbols":                    {{0x2100, 0x214F}},
+	"NumberForms":                          {{0x2150, 0x218F}},
+	"Arrows":                               {{0x2190, 0x21FF}},
+	"MathematicalOperators":                {{0x2200, 0x22FF}},
+	"MiscellaneousTechnical":               {{0x2300, 0x23FF}},
+	"ControlPictures":                      {{0x2400, 0x243F}},
+	"OpticalCharacterRecognition":          {{0x2440, 0x245F}},
+	"EnclosedAlphanumerics":                {{0x2460, 0x24FF}},
+	"BoxDrawing":                           {{0x2500, 0x257F}},
+	"BlockElements":                        {{0x2580, 0x259F}},
+	"GeometricShapes":                      {{0x25A0, 0x25FF}},
+	"MiscellaneousSymbols":                 {{0x2600, 0x26FF}},
+	"Dingbats":                             {{0x2700, 0x27BF}},
+	"BraillePatterns":                      {{0x2800, 0x28FF}},
+	"CJKRadicalsSupplement":                {{0x2E80, 0x2EFF}},
+	"KangxiRadicals":                       {{0x2F00, 0x2FDF}},
+	"IdeographicDescriptionCharacters":     {{0x2FF0, 0x2FFF}},
+	"CJKSymbolsandPunctuation":             {{0x3000, 0x303F}},
+	"Hiragana":                             {{0x3040, 0x309F}},
+	"Katakana":                             {{0x30A0, 0x30FF}},
+	"Bopomofo":                             {{0x3100, 0x312F}},
+	"HangulCompatibilityJamo":              {{0x3130, 0x318F}},
+	"Kanbun":                               {{0x3190, 0x319F}},
+	"BopomofoExtended":                     {{0x31A0, 0x31BF}},
+	"EnclosedCJKLettersandMonths":          {{0x3200, 0x32FF}},
+	"CJKCompatibility":                     {{0x3300, 0x33FF}},
+	"CJKUnifiedIdeographsExtensionA":       {{0x3400, 0x4DB5}},
+	"CJKUnifiedIdeographs":                 {{0x4E00, 0x9FFF}},
+	"YiSyllables":                          {{0xA000, 0xA48F}},
+	"YiRadicals":                           {{0xA490, 0xA4CF}},
+	"HangulSyllables":                      {{0xAC00, 0xD7A3}},
+	"HighSurrogates":                       {{0xD800, 0xDB7F}},
+	"HighPrivateUseSurrogates":             {{0xDB80, 0xDBFF}},
+	"LowSurrogates":                        {{0xDC00, 0xDFFF}},
+	"PrivateUse":                           {{0xE000, 0xF8FF}, {0xF0000, 0xFFFFD}, {0x100000, 0x10FFFD}},
+	"CJKCompatibilityIdeographs":           {{0xF900, 0xFAFF}},
+	"AlphabeticPresentationForms":          {{0xFB00, 0xFB4F}},
+	"ArabicPresentationForms-A":            {{0xFB50, 0xFDFF}},
+	"CombiningHalfMarks":                   {{0xFE20, 0xFE2F}},
+	"CJKCompatibilityForms":                {{0xFE30, 0xFE4F}},
+	"SmallFormVariants":                    {{0xFE50, 0xFE6F}},
+	"ArabicPresentationForms-B":            {{0xFE70, 0xFEFE}},
+	"Specials":                             {{0xFEFF, 0xFEFF}, {0xFFF0, 0xFFFD}},
+	"HalfwidthandFullwidthForms":           {{0xFF00, 0xFFEF}},
+	"OldItalic":                            {{0x10300, 0x1032F}},
+	"Gothic":                               {{0x10330, 0x1034F}},
+	"Deseret":                              {{0x10400, 0x1044F}},
+	"ByzantineMusicalSymbols":              {{0x1D000, 0x1D0FF}},
+	"MusicalSymbols":                       {{0x1D100, 0x1D1FF}},
+	"MathematicalAlphanumericSymbols":      {{0x1D400, 0x1D7FF}},
+	"CJKUnifiedIdeographsExtensionB":       {{0x20000, 0x2A6D6}},
+	"CJKCompatibilityIdeographsSupplement": {{0x2F800, 0x2FA1F}},
+	"Tags":                                 {{0xE0000, 0xE007F}},
+}
+
+//	The Unicode general categories that XSD 1.0 defines \p{..} escapes for, besides Cn and the major categories.
+var xsdCategories = []string{"Lu", "Ll", "Lt", "Lm", "Lo", "Mn", "Mc", "Me", "Nd", "Nl", "No", "Pc", "Pd", "Ps",
+	"Pe", "Pi", "Pf", "Po", "Zs", "Zl", "Zp", "Sm", "Sc", "Sk", "So", "Cc", "Cf", "Co"}
+
+var (
+	categoriesOnce sync.Once
+	categorySets   map[string]runeSet
+)
+
+//	Returns the set for one of the Unicode general categories that XSD 1.0 defines \p{..} escapes for.
+func categorySet(name string) (runeSet, bool) {
+	categoriesOnce.Do(func() {
+		categorySets = map[string]runeSet{}
+		assigned := tableSet(unicode.Cs)
+		for _, cat := range xsdCategories {
+			categorySets[cat] = tableSet(unicode.Categories[cat])
+			assigned = assigned.union(categorySets[cat])
+		}
+		//	Older Go releases have no table for unassigned code points, so it is derived from the others.
+		categorySets["Cn"] = assigned.negate()
+		for _, cat := range append(xsdCategories, "Cn") {
+			major := cat[:1]
+			categorySets[major] = categorySets[major].union(categorySets[cat])
+		}
+	})
+	set, ok := categorySets[name]
+	return set, ok
+}
+
+type reParser struct {
+	expr string
+	pos  int
+}
+
+func (me *reParser) fail(msg string) error {
+	return &RegexpError{Expr: me.expr, Offset: me.pos, Msg: msg}
+}
+
+func (me *reParser) peek() (rune, int) {
+	if me.pos >= len(me.expr) {
+		return -1, 0
+	}
+	return utf8.DecodeRuneInString(me.expr[me.pos:])
+}
+
+func (me *reParser) peekAt(offset int) byte {
+	if me.pos+offset >= len(me.expr) {
+		return 0
+	}
+	return me.expr[me.pos+offset]
+}
+
+//	regExp ::= branch ( '|' branch )*
+func (me *reParser) regExp() (string, error) {
+	var branches []string
+	for {
+		b, err := me.branch()
+		if err != nil {
+			return "", err
+		}
+		branches = append(branches, b)
+		if r, _ := me.peek(); r != '|' {
+			return strings.Join(branches, "|"), nil
+		}
+		me.pos++
+	}
+}
+
+//	branch ::= piece*
+func (me *reParser) branch() (string, error) {
+	var b strings.Builder
+	for {
+		if r, _ := me.peek(); r < 0 || r == '|' || r == ')' {
+			return b.String(), nil
+		}
+		p, err := me.piece()
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(p)
+	}
+}
+
+//	piece ::= atom quantifier?
+func (me *reParser) piece() (string, error) {
+	atom, err := me.atom()
+	if err != nil {
+		return "", err
+	}
+	r, _ := me.peek()
+	switch r {
+	case '?', '*', '+':
+		me.pos++
+		atom += string(r)
+	case '{':
+		q, err := me.quantity()
+		if err != nil {
+			return "", err
+		}
+		atom += q
+	default:
+		return atom, nil
+	}
+	if r, _ = me.peek(); r == '?' || r == '*' || r == '+' || r == '{' {
+		return "", me.fail("quantifier follows quantifier (XSD has no lazy or possessive quantifiers)")
+	}
+	return atom, nil
+}
+
+//	quantifier ::= '{' ( n | n ',' | n ',' m ) '}'
+func (me *reParser) quantity() (string, error) {
+	start := me.pos
+	end := strings.IndexByte(me.expr[me.pos:], '}')
+	if end < 0 {
+		return "", me.fail("missing } in quantifier")
+	}
+	body := me.expr[me.pos+1 : me.pos+end]
+	min, max, hasComma := strings.Cut(body, ",")
+	if !isDigits(min) || len(min) == 0 || !isDigits(max) || (len(max) > 0 && !hasComma) {
+		return "", me.fail("malformed quantifier {" + body + "}")
+	}
+	if len(max) > 0 {
+		lo, _ := strconv.Atoi(min)
+		hi, _ := strconv.Atoi(max)
+		if hi < lo {
+			return "", me.fail("quantifier {" + body + "} has maximum less than minimum")
+		}
+	}
+	me.pos = start + end + 1
+	return "{" + body + "}", nil
+}
+
+//	atom ::= Char | charClass | '(' regExp ')'
+func (me *reParser) atom() (string, error) {
+	r, size := me.peek()
+	switch r {
+	case '(':
+		me.pos++
+		if me.peekAt(0) == '?' {
+			return "", me.fail("(? groups are not supported; XSD groups are plain parentheses")
+		}
+		inner, err := me.regExp()
+		if err != nil {
+			return "", err
+		}
+		if r, _ := me.peek(); r != ')' {
+			return "", me.fail("missing )")
+		}
+		me.pos++
+		return "(?:" + inner + ")", nil
+	case '[':
+		me.pos++
+		set, err := me.charClassExpr()
+		if err != nil {
+			return "", err
+		}
+		return set.goSyntax(), nil
+	case '.':
+		me.pos++
+		return runeSet{{'\n', '\n'}, {'\r', '\r'}}.negate().goSyntax(), nil
+	case '\\':
+		single, set, err := me.escape(false)
+		if err != nil {
+			return "", err
+		}
+		if set != nil {
+			return set.goSyntax(), nil
+		}
+		return regexp.QuoteMeta(string(single)), nil
+	case '?', '*', '+', '{':
+		return "", me.fail("quantifier " + string(r) + " without preceding atom")
+	case ']', '}':
+		return "", me.fail("unescaped " + string(r))
+	}
+	me.pos += size
+	return regexp.QuoteMeta(string(r)), nil
+}
+
+//	Parses an escape at the current position. Single character escapes return the character; multi-character
+//	and category escapes return a set. If inRange is set, only single character escapes are allowed.
+func (me *reParser) escape(inRange bool) (rune, runeSet, error) {
+	start := me.pos
+	me.pos++
+	r, size := me.peek()
+	if r < 0 {
+		return 0, nil, me.fail("trailing backslash")
+	}
+	me.pos += size
+	switch r {
+	case 'n':
+		return '\n', nil, nil
+	case 'r':
+		return '\r', nil, nil
+	case 't':
+		return '\t', nil, nil
+	case '\\', '|', '.', '?', '*', '+', '(', ')', '{', '}', '-', '[', ']', '^':
+		return r, nil, nil
+	}
+	var set runeSet
+	switch r {
+	case 's', 'S':
+		set = runeSet{{'\t', '\n'}, {'\r', '\r'}, {' ', ' '}}
+	case 'i', 'I':
+		set = nameStartSet
+	case 'c', 'C':
+		set = nameSet
+	case 'd', 'D':
+		set, _ = categorySet("Nd")
+	case 'w', 'W':
+		p, _ := categorySet("P")
+		z, _ := categorySet("Z")
+		c, _ := categorySet("C")
+		set = p.union(z).union(c).negate()
+	case 'p', 'P':
+		if me.peekAt(0) != '{' {
+			return 0, nil, me.fail("missing { after \\" + string(r))
+		}
+		end := strings.IndexByte(me.expr[me.pos:], '}')
+		if end < 0 {
+			return 0, nil, me.fail("missing } in \\" + string(r) + "{...}")
+		}
+		name := me.expr[me.pos+1 : me.pos+end]
+		var ok bool
+		if block, isBlock := strings.CutPrefix(name, "Is"); isBlock {
+			set, ok = unicodeBlocks[block]
+		} else {
+			set, ok = categorySet(name)
+		}
+		if !ok {
+			me.pos = start
+			return 0, nil, me.fail("unknown Unicode category or block \\" + string(r) + "{" + name + "}")
+		}
+		me.pos += end + 1
+		if r == 'P' {
+			set = set.negate()
+		}
+	default:
+		me.pos = start
+		switch {
+		case r >= '0' && r <= '9':
+			return 0, nil, me.fail("back-references are not supported in XSD patterns")
+		case r == 'b' || r == 'B' || r == 'A' || r == 'z' || r == 'Z':
+			return 0, nil, me.fail("anchor \\" + string(r) + " is not supported; XSD patterns always match the whole value")
+		case r == 'x' || r == 'u' || r == 'U':
+			return 0, nil, me.fail("\\" + string(r) + " escapes are not supported; use an &#x...; character reference in the schema")
+		}
+		return 0, nil, me.fail("unknown escape \\" + string(r))
+	}
+	if r >= 'A' && r <= 'Z' && r != 'P' {
+		set = set.negate()
+	}
+	if inRange {
+		me.pos = start
+		return 0, nil, me.fail("\\" + string(r) + " cannot be a range endpoint")
+	}
+	return 0, set, nil
+}
+
+//	charClassExpr ::= '[' '^'? ( charRange | charClassEsc )+ ( '-' charClassExpr )? ']', the '[' having been read.
+func (me *reParser) charClassExpr() (runeSet, error) {
+	negated := false
+	if me.peekAt(0) == '^' {
+		negated = true
+		me.pos++
+	}
+	var set, sub runeSet
+	first := true
+	for {
+		r, size := me.peek()
+		switch {
+		case r < 0:
+			return nil, me.fail("missing ]")
+		case r == ']':
+			if first {
+				return nil, me.fail("empty character class")
+			}
+			me.pos++
+			if negated {
+				set = set.negate()
+			}
+			if sub != nil {
+				set = set.subtract(sub)
+			}
+			return set, nil
+		case r == '-' && me.peekAt(1) == '[':
+			if first {
+				return nil, me.fail("character class subtraction without a class to subtract from")
+			}
+			me.pos += 2
+			s, err := me.charClassExpr()
+			if err != nil {
+				return nil, err
+			}
+			if sub = s; sub == nil {
+				sub = runeSet{}
+			}
+			if me.peekAt(0) != ']' {
+				return nil, me.fail("character class subtraction must come last in a class")
+			}
+			continue
+		case r == '-' && !first && me.peekAt(1) != ']':
+			return nil, me.fail("unescaped - in character class")
+		case r == '[':
+			return nil, me.fail("unescaped [ in character class (subtraction is written [a-z-[aeiou]])")
+		}
+		first = false
+		start, lo := me.pos, r
+		if r == '\\' {
+			single, multi, err := me.escape(false)
+			if err != nil {
+				return nil, err
+			}
+			if multi != nil {
+				set = set.union(multi)
+				continue
+			}
+			lo = single
+		} else {
+			me.pos += size
+		}
+		hi := lo
+		if me.peekAt(0) == '-' && me.peekAt(1) != '[' && me.peekAt(1) != ']' && me.peekAt(1) != 0 {
+			me.pos++
+			r, size := me.peek()
+			switch {
+			case r == '\\':
+				single, _, err := me.escape(true)
+				if err != nil {
+					return nil, err
+				}
+				hi = single
+			case r == '[' || r == '-':
+				return nil, me.fail("unescaped " + string(r) + " as range endpoint")
+			default:
+				hi = r
+				me.pos += size
+			}
+			if hi < lo {
+				me.pos = start
+				return nil, me.fail("range " + strconv.QuoteRune(lo) + "-" + strconv.QuoteRune(hi) + " is out of order")
+			}
+		}
+		set = set.union(runeSet{{lo, hi}})
+	}
+}
@@ -0,0 +1,224 @@
+package xsdt
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+)
+
+//	The namespace of the XSD built-in types.
+const XsdNamespace = "http://www.w3.org/2001/XMLSchema"
+
+//	Says whether a simple type is atomic or a list type.
+type Variety int
+
+const (
+	//	The variety of xs:anyType and xs:anySimpleType, which are neither atomic nor lists.
+	VarietyAbsent Variety = iota
+
+	//	The values of the type are indivisible, e.g. xs:int.
+	VarietyAtomic
+
+	//	The values of the type are whitespace-separated lists of values of an item type, e.g. xs:IDREFS.
+	VarietyList
+)
+
+//	Returns "absent", "atomic" or "list".
+func (me Variety) String() string {
+	switch me {
+	case VarietyAtomic:
+		return "atomic"
+	case VarietyList:
+		return "list"
+	}
+	return "absent"
+}
+
+//	Describes one of the XSD built-in types and the xsdt type that represents it.
+type TypeInfo struct {
+	//	The XSD name of the type, e.g. "positiveInteger".
+	Name string
+
+	//	The namespace the type is defined in, which is XsdNamespace for all built-in types.
+	Namespace string
+
+	//	The type this one is derived from, or nil for xs:anyType.
+	Base *TypeInfo
+
+	//	The primitive type this one is derived from (itself, for primitive types), or nil for xs:anyType,
+	//	xs:anySimpleType and list types.
+	Primitive *TypeInfo
+
+	//	Whether the type is atomic or a list type.
+	Variety Variety
+
+	//	The item type of a list type, or nil.
+	ItemType *TypeInfo
+
+	//	The whiteSpace facet of the type.
+	WhiteSpace WhiteSpace
+
+	//	The constraining facets the type adds to its base type, as given in XML Schema Part 2. Pass them to
+	//	NewRestriction to use them; the built-in types are validated by Validate without them.
+	Facets []Facet
+
+	//	The xsdt type that represents values of the type, e.g. reflect.TypeOf(PositiveInteger(0)).
+	GoType reflect.Type
+
+	//	The slice-backed xsdt type that represents values of a list type too, e.g. IdrefList, or nil.
+	ListGoType reflect.Type
+}
+
+//	Returns the qualified name of the type.
+func (me *TypeInfo) QName() xml.Name {
+	return xml.Name{Space: me.Namespace, Local: me.Name}
+}
+
+//	Returns the name of the type with the conventional "xs" prefix, e.g. "xs:positiveInteger".
+func (me *TypeInfo) String() string {
+	return "xs:" + me.Name
+}
+
+//	Reports whether the type is other or derived from it, directly or indirectly.
+func (me *TypeInfo) DerivesFrom(other *TypeInfo) bool {
+	for t := me; t != nil; t = t.Base {
+		if t == other {
+			return true
+		}
+	}
+	return false
+}
+
+//	Checks s against the lexical space of the type (after applying its whiteSpace facet), reporting the same
+//	errors as the Parse or Validate method of GoType. Values of xs:anyType, xs:anySimpleType and types without
+//	lexical constraints, such as xs:string, are always valid.
+func (me *TypeInfo) Validate(s string) error {
+	if b, ok := builtinBases[me.Name]; ok && b.check != nil {
+		return b.check(me.WhiteSpace.Apply(s))
+	}
+	return nil
+}
+
+var (
+	typesByName   = map[string]*TypeInfo{}
+	typesByGoType = map[reflect.Type]*TypeInfo{}
+	builtinTypes  []*TypeInfo
+)
+
+func defineType(name, base string, variety Variety, ws WhiteSpace, goValue interface{}, facets ...Facet) *TypeInfo {
+	t := &TypeInfo{Name: name, Namespace: XsdNamespace, Variety: variety, WhiteSpace: ws, Facets: facets,
+		GoType: reflect.TypeOf(goValue)}
+	if base != "" {
+		t.Base = typesByName[base]
+		if t.Base.Name == "anySimpleType" && variety == VarietyAtomic {
+			t.Primitive = t
+		} else {
+			t.Primitive = t.Base.Primitive
+		}
+	}
+	typesByName[name] = t
+	typesByGoType[t.GoType] = t
+	builtinTypes = append(builtinTypes, t)
+	return t
+}
+
+func defineListType(name, item string, goValue, listValue interface{}) {
+	t := defineType(name, "anySimpleType", VarietyList, WhiteSpaceCollapse, goValue, MinLength(1))
+	t.Primitive, t.ItemType = nil, typesByName[item]
+	t.ListGoType = reflect.TypeOf(listValue)
+	typesByGoType[t.ListGoType] = t
+}
+
+func init() {
+	c := WhiteSpaceCollapse
+	defineType("anyType", "", VarietyAbsent, WhiteSpacePreserve, AnyType(""))
+	defineType("anySimpleType", "anyType", VarietyAbsent, WhiteSpacePreserve, AnySimpleType(""))
+
+	defineType("string", "anySimpleType", VarietyAtomic, WhiteSpacePreserve, String(""))
+	defineType("boolean", "anySimpleType", VarietyAtomic, c, Boolean(false))
+	defineType("decimal", "anySimpleType", VarietyAtomic, c, Decimal{})
+	defineType("float", "anySimpleType", VarietyAtomic, c, Float(0))
+	defineType("double", "anySimpleType", VarietyAtomic, c, Double(0))
+	defineType("duration", "anySimpleType", VarietyAtomic, c, Duration(""))
+	defineType("dateTime", "anySimpleType", VarietyAtomic, c, DateTime(""))
+	defineType("time", "anySimpleType", VarietyAtomic, c, Time(""))
+	defineType("date", "anySimpleType", VarietyAtomic, c, Date(""))
+	defineType("gYearMonth", "anySimpleType", VarietyAtomic, c, GYearMonth(""))
+	defineType("gYear", "anySimpleType", VarietyAtomic, c, GYear(""))
+	defineType("gMonthDay", "anySimpleType", VarietyAtomic, c, GMonthDay(""))
+	defineType("gDay", "anySimpleType", VarietyAtomic, c, GDay(""))
+	defineType("gMonth", "anySimpleType", VarietyAtomic, c, GMonth(""))
+	defineType("hexBinary", "anySimpleType", VarietyAtomic, c, HexBinary(""))
+	defineType("base64Binary", "anySimpleType", VarietyAtomic, c, Base64Binary(""))
+	defineType("anyURI", "anySimpleType", VarietyAtomic, c, AnyURI(""))
+	defineType("QName", "anySimpleType", VarietyAtomic, c, Qname(""))
+	defineType("NOTATION", "anySimpleType", VarietyAtomic, c, Notation(""))
+
+	defineType("normalizedString", "string", VarietyAtomic, WhiteSpaceReplace, NormalizedString(""))
+	defineType("token", "normalizedString", VarietyAtomic, c, Token(""))
+	defineType("language", "token", VarietyAtomic, c, Language(""), Pattern(`[a-zA-Z]{1,8}(-[a-zA-Z0-9]{1,8})*`))
+	defineType("NMTOKEN", "token", VarietyAtomic, c, Nmtoken(""), Pattern(`\c+`))
+	defineType("Name", "token", VarietyAtomic, c, Name(""), Pattern(`\i\c*`))
+	defineType("NCName", "Name", VarietyAtomic, c, NCName(""), Pattern(`[\i-[:]][\c-[:]]*`))
+	defineType("ID", "NCName", VarietyAtomic, c, Id(""))
+	defineType("IDREF", "NCName", VarietyAtomic, c, Idref(""))
+	defineType("ENTITY", "NCName", VarietyAtomic, c, Entity(""))
+	defineListType("NMTOKENS", "NMTOKEN", Nmtokens(""), NmtokenList(nil))
+	defineListType("IDREFS", "IDREF", Idrefs(""), IdrefList(nil))
+	defineListType("ENTITIES", "ENTITY", Entities(""), EntityList(nil))
+
+	defineType("integer", "decimal", VarietyAtomic, c, Integer{}, FractionDigits(0), Pattern(`[\-+]?[0-9]+`))
+	defineType("nonPositiveInteger", "integer", VarietyAtomic, c, NonPositiveInteger(0), MaxInclusive("0"))
+	defineType("negativeInteger", "nonPositiveInteger", VarietyAtomic, c, NegativeInteger(0), MaxInclusive("-1"))
+	defineType("long", "integer", VarietyAtomic, c, Long(0), MinInclusive("-9223372036854775808"), MaxInclusive("9223372036854775807"))
+	defineType("int", "long", VarietyAtomic, c, Int(0), MinInclusive("-2147483648"), MaxInclusive("2147483647"))
+	defineType("short", "int", VarietyAtomic, c, Short(0), MinInclusive("-32768"), MaxInclusive("32767"))
+	defineType("byte", "short", VarietyAtomic, c, Byte(0), MinInclusive("-128"), MaxInclusive("127"))
+	defineType("nonNegativeInteger", "integer", VarietyAtomic, c, NonNegativeInteger(0), MinInclusive("0"))
+	defineType("unsignedLong", "nonNegativeInteger", VarietyAtomic, c, UnsignedLong(0), MaxInclusive("18446744073709551615"))
+	defineType("unsignedInt", "unsignedLong", VarietyAtomic, c, UnsignedInt(0), MaxInclusive("4294967295"))
+	defineType("unsignedShort", "unsignedInt", VarietyAtomic, c, UnsignedShort(0), MaxInclusive("65535"))
+	defineType("unsignedByte", "unsignedShort", VarietyAtomic, c, UnsignedByte(0), MaxInclusive("255"))
+	defineType("positiveInteger", "nonNegativeInteger", VarietyAtomic, c, PositiveInteger(0), MinInclusive("1"))
+}
+
+//	Returns all built-in types, base types before the types derived from them.
+func BuiltinTypes() []*TypeInfo {
+	return append([]*TypeInfo(nil), builtinTypes...)
+}
+
+//	Returns the built-in type with the specified qualified name, or nil.
+func LookupType(name xml.Name) *TypeInfo {
+	if name.Space != XsdNamespace {
+		return nil
+	}
+	return typesByName[name.Local]
+}
+
+//	Returns the built-in type a QName such as "xs:positiveInteger" refers to, resolving its prefix against the
+//	specified prefix to namespace map (see Qname.Resolve). If namespaces is nil, the customary "xs" and "xsd"
+//	prefixes are assumed to be bound to XsdNamespace.
+func LookupQName(qname Qname, namespaces map[string]string) (*TypeInfo, error) {
+	if namespaces == nil {
+		namespaces = map[string]string{"xs": XsdNamespace, "xsd": XsdNamespace}
+	}
+	name, err := qname.Resolve(namespaces)
+	if err != nil {
+		return nil, err
+	}
+	t := LookupType(name)
+	if t == nil {
+		return nil, fmt.Errorf("xsdt: %s is not a built-in type", qname)
+	}
+	return t, nil
+}
+
+//	Returns the built-in type represented by the xsdt type t, e.g. xs:IDREFS for both Idrefs and IdrefList.
+//	Pointer types are dereferenced. It returns nil if t is not an xsdt type; types derived from xsdt types,
+//	such as type MyCode xsdt.Token, are not recognized, since Go keeps no record of that.
+func TypeOf(t reflect.Type) *TypeInfo {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return typesByGoType[t]
+}
@@ -0,0 +1,427 @@
+package xsdt
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+//	Returned by the Validate methods when a value violates a lexical constraint of its type.
+type ValidationError struct {
+	//	The XSD name of the type being validated, e.g. "NCName".
+	Type string
+
+	//	The offending value.
+	Value string
+
+	//	The production or constraint that failed, e.g. "NameStartChar" or "BCP 47 region subtag".
+	Rule string
+
+	//	Byte offset into Value at which the failure was detected, or -1 if it concerns the value as a whole.
+	Offset int
+}
+
+func (me *ValidationError) Error() string {
+	msg := "xsdt: " + strconv.Quote(me.Value) + " is not a valid xs:" + me.Type + ": violates " + me.Rule
+	if me.Offset >= 0 {
+		msg += " at offset " + strconv.Itoa(me.Offset)
+	}
+	return msg
+}
+
+//	Reports whether r may start an XML Name, as per production [4] NameStartChar of XML 1.0 (Fifth Edition).
+func IsNameStartChar(r rune) bool {
+	switch {
+	case r == ':' || r == '_' || (r >= 'A' && r <= 'Z') || (r >= 'a' && r <= 'z'):
+		return true
+	case r < 0xC0:
+		return false
+	}
+	return (r <= 0xD6) || (r >= 0xD8 && r <= 0xF6) || (r >= 0xF8 && r <= 0x2FF) ||
+		(r >= 0x370 && r <= 0x37D) || (r >= 0x37F && r <= 0x1FFF) || (r >= 0x200C && r <= 0x200D) ||
+		(r >= 0x2070 && r <= 0x218F) || (r >= 0x2C00 && r <= 0x2FEF) || (r >= 0x3001 && r <= 0xD7FF) ||
+		(r >= 0xF900 && r <= 0xFDCF) || (r >= 0xFDF0 && r <= 0xFFFD) || (r >= 0x10000 && r <= 0xEFFFF)
+}
+
+//	Reports whether r may occur in an XML Name, as per production [4a] NameChar of XML 1.0 (Fifth Edition).
+func IsNameChar(r rune) bool {
+	return IsNameStartChar(r) || r == '-' || r == '.' || (r >= '0' && r <= '9') || r == 0xB7 ||
+		(r >= 0x300 && r <= 0x36F) || (r >= 0x203F && r <= 0x2040)
+}
+
+//	Checks s against the Name (or, if colons are disallowed, NCName) production.
+func validateName(typ, s string, colons, nmtoken bool) error {
+	if len(s) == 0 {
+		return &ValidationError{Type: typ, Value: s, Rule: "non-empty value", Offset: -1}
+	}
+	for i, r := range s {
+		if r == utf8.RuneError {
+			return &ValidationError{Type: typ, Value: s, Rule: "UTF-8 encoding", Offset: i}
+		}
+		if r == ':' && !colons {
+			return &ValidationError{Type: typ, Value: s, Rule: "NCName (no colons)", Offset: i}
+		}
+		if i == 0 && !nmtoken && !IsNameStartChar(r) {
+			return &ValidationError{Type: typ, Value: s, Rule: "NameStartChar", Offset: i}
+		}
+		if !IsNameChar(r) {
+			return &ValidationError{Type: typ, Value: s, Rule: "NameChar", Offset: i}
+		}
+	}
+	return nil
+}
+
+//	Checks the current value against the XML Name production.
+func (me Name) Validate() error {
+	return validateName("Name", string(me), true, false)
+}
+
+//	Reports whether Validate succeeds.
+func (me Name) IsValid() bool {
+	return me.Validate() == nil
+}
+
+//	Checks the current value against the XML Namespaces NCName production, i.e. a Name without colons.
+func (me NCName) Validate() error {
+	return validateName("NCName", string(me), false, false)
+}
+
+//	Reports whether Validate succeeds.
+func (me NCName) IsValid() bool {
+	return me.Validate() == nil
+}
+
+//	Checks the current value against the NCName production. Uniqueness within a document is not checked here.
+func (me Id) Validate() error {
+	return validateName("ID", string(me), false, false)
+}
+
+//	Reports whether Validate succeeds.
+func (me Id) IsValid() bool {
+	return me.Validate() == nil
+}
+
+//	Checks the current value against the NCName production. Whether it refers to an ID is not checked here.
+func (me Idref) Validate() error {
+	return validateName("IDREF", string(me), false, false)
+}
+
+//	Reports whether Validate succeeds.
+func (me Idref) IsValid() bool {
+	return me.Validate() == nil
+}
+
+//	Checks the current value against the NCName production.
+func (me Entity) Validate() error {
+	return validateName("ENTITY", string(me), false, false)
+}
+
+//	Reports whether Validate succeeds.
+func (me Entity) IsValid() bool {
+	return me.Validate() == nil
+}
+
+//	Checks the current value against the XML Nmtoken production: one or more NameChars.
+func (me Nmtoken) Validate() error {
+	return validateName("NMTOKEN", string(me), true, true)
+}
+
+//	Reports whether Validate succeeds.
+func (me Nmtoken) IsValid() bool {
+	return me.Validate() == nil
+}
+
+//	Splits the current value into its prefix (empty if there is none) and local part.
+func (me Qname) Split() (prefix, local string) {
+	if i := strings.IndexByte(string(me), ':'); i >= 0 {
+		return string(me[:i]), string(me[i+1:])
+	}
+	return "", string(me)
+}
+
+//	Checks the current value against the XML Namespaces QName production: an optional NCName prefix and a colon,
+//	followed by an NCName local part.
+func (me Qname) Validate() error {
+	prefix, local := me.Split()
+	if i := strings.IndexByte(string(me), ':'); i >= 0 {
+		if err := validateName("QName", prefix, false, false); err != nil {
+			err.(*ValidationError).Rule = "prefix " + err.(*ValidationError).Rule
+			err.(*ValidationError).Value = string(me)
+			return err
+		}
+		if err := validateName("QName", local, false, false); err != nil {
+			verr := err.(*ValidationError)
+			verr.Rule, verr.Value = "local part "+verr.Rule, string(me)
+			if verr.Offset >= 0 {
+				verr.Offset += i + 1
+			}
+			return verr
+		}
+		return nil
+	}
+	return validateName("QName", local, false, false)
+}
+
+//	Reports whether Validate succeeds.
+func (me Qname) IsValid() bool {
+	return me.Validate() == nil
+}
+
+//	Resolves the current value against the in-scope namespace declarations given as a prefix to URI map, in which
+//	the key "" holds the default namespace (if any). The "xml" prefix is always bound, as XML Namespaces demands.
+func (me Qname) Resolve(namespaces map[string]string) (xml.Name, error) {
+	if err := me.Validate(); err != nil {
+		return xml.Name{}, err
+	}
+	prefix, local := me.Split()
+	if prefix == "xml" {
+		return xml.Name{Space: "http://www.w3.org/XML/1998/namespace", Local: local}, nil
+	}
+	uri, ok := namespaces[prefix]
+	if !ok && prefix != "" {
+		return xml.Name{}, &ValidationError{Type: "QName", Value: string(me), Rule: "declared namespace prefix", Offset: 0}
+	}
+	return xml.Name{Space: uri, Local: local}, nil
+}
+
+//	Irregular grandfathered tags, which do not follow the BCP 47 langtag production but are nonetheless valid.
+var bcp47Irregular = map[string]bool{
+	"en-gb-oed": true, "i-ami": true, "i-bnn": true, "i-default": true, "i-enochian": true, "i-hak": true,
+	"i-klingon": true, "i-lux": true, "i-mingo": true, "i-navajo": true, "i-pwn": true, "i-tao": true,
+	"i-tay": true, "i-tsu": true, "sgn-be-fr": true, "sgn-be-nl": true, "sgn-ch-de": true,
+}
+
+func isAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i] | 0x20; c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanum(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isAlpha(s[i:i+1]) && !isDigits(s[i:i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+//	Checks the current value against the BCP 47 (RFC 5646) language tag grammar, e.g. "de", "de-CH",
+//	"zh-Hant-TW" or "sr-Latn-x-custom". Subtags are not checked against the IANA registry, with one
+//	exception: primary language subtags of four or more letters are rejected since none are registered,
+//	which catches mistakes such as "english".
+func (me Language) Validate() error {
+	s := string(me)
+	fail := func(rule string, offset int) error {
+		return &ValidationError{Type: "language", Value: s, Rule: "BCP 47 " + rule, Offset: offset}
+	}
+	if len(s) == 0 {
+		return fail("language tag (non-empty)", -1)
+	}
+	lower := strings.ToLower(s)
+	if bcp47Irregular[lower] {
+		return nil
+	}
+	subtags := strings.Split(lower, "-")
+	offsets := make([]int, len(subtags))
+	for i, off := 1, 0; i < len(subtags); i++ {
+		off += len(subtags[i-1]) + 1
+		offsets[i] = off
+	}
+	for i, sub := range subtags {
+		if len(sub) == 0 || len(sub) > 8 || !isAlphanum(sub) {
+			return fail("subtag syntax (1 to 8 letters or digits)", offsets[i])
+		}
+	}
+	i := 0
+	if subtags[0] != "x" {
+		//	language ["-" extlang] ["-" script] ["-" region] *("-" variant) *("-" extension)
+		if !isAlpha(subtags[0]) || len(subtags[0]) < 2 {
+			return fail("primary language subtag", 0)
+		} else if len(subtags[0]) > 3 {
+			return fail("primary language subtag (2 or 3 letters)", 0)
+		}
+		i++
+		for n := 0; n < 3 && i < len(subtags) && len(subtags[i]) == 3 && isAlpha(subtags[i]); n++ {
+			i++
+		}
+		if i < len(subtags) && len(subtags[i]) == 4 && isAlpha(subtags[i]) {
+			i++
+		}
+		if i < len(subtags) && ((len(subtags[i]) == 2 && isAlpha(subtags[i])) || (len(subtags[i]) == 3 && isDigits(subtags[i]))) {
+			i++
+		}
+		variants := map[string]bool{}
+		for i < len(subtags) {
+			sub := subtags[i]
+			if !(len(sub) >= 5 || (len(sub) == 4 && isDigits(sub[:1]))) {
+				break
+			}
+			if variants[sub] {
+				return fail("variant subtag (duplicate)", offsets[i])
+			}
+			variants[sub] = true
+			i++
+		}
+		singletons := map[string]bool{}
+		for i < len(subtags) && len(subtags[i]) == 1 && subtags[i] != "x" {
+			if singletons[subtags[i]] {
+				return fail("extension singleton (duplicate)", offsets[i])
+			}
+			singletons[subtags[i]] = true
+			start := i
+			for i++; i < len(subtags) && len(subtags[i]) >= 2; i++ {
+			}
+			if i == start+1 {
+				return fail("extension subtag (2 to 8 characters)", offsets[start])
+			}
+		}
+	}
+	if i < len(subtags) && subtags[i] == "x" {
+		if i == len(subtags)-1 {
+			return fail("private use subtag", offsets[i])
+		}
+		return nil
+	}
+	if i < len(subtags) {
+		switch {
+		case len(subtags[i]) == 4 && isAlpha(subtags[i]):
+			return fail("script subtag (position)", offsets[i])
+		case len(subtags[i]) == 2 || len(subtags[i]) == 3:
+			return fail("region subtag", offsets[i])
+		case len(subtags[i]) == 1:
+			return fail("extension subtag (2 to 8 characters)", offsets[i])
+		}
+		return fail("variant subtag", offsets[i])
+	}
+	return nil
+}
+
+//	Reports whether Validate succeeds.
+func (me Language) IsValid() bool {
+	return me.Validate() == nil
+}
+
+func isHex(c byte) bool {
+	return (c >= '0' && c <= '9') || (c|0x20 >= 'a' && c|0x20 <= 'f')
+}
+
+//	Reports whether c may appear literally in some component of an RFC 3986 URI reference.
+func isURIChar(c byte) bool {
+	switch {
+	case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9'):
+		return true
+	case c >= 0x80:
+		//	Non-ASCII characters are allowed, since anyURI values are IRIs (RFC 3987) that are escaped on use.
+		return true
+	}
+	return strings.IndexByte("-._~:/?#[]@!$&'()*+,;=%", c) >= 0
+}
+
+//	Checks the current value against the RFC 3986 URI-reference grammar: the characters used, percent-encoding,
+//	the scheme, and the structure of the authority, query and fragment. Relative references are allowed, and so
+//	are non-ASCII characters, as XSD defines anyURI in terms of IRIs.
+func (me AnyURI) Validate() error {
+	s := string(me)
+	fail := func(rule string, offset int) error {
+		return &ValidationError{Type: "anyURI", Value: s, Rule: "RFC 3986 " + rule, Offset: offset}
+	}
+	for i := 0; i < len(s); i++ {
+		if !isURIChar(s[i]) {
+			return fail("character set", i)
+		}
+		if s[i] == '%' && (i+2 >= len(s) || !isHex(s[i+1]) || !isHex(s[i+2])) {
+			return fail("percent-encoding", i)
+		}
+	}
+	rest, offset := s, 0
+	if i := strings.IndexAny(rest, ":/?#"); i >= 0 && rest[i] == ':' {
+		scheme := rest[:i]
+		if len(scheme) == 0 || !isAlpha(scheme[:1]) {
+			return fail("scheme", 0)
+		}
+		for j := 1; j < len(scheme); j++ {
+			if c := scheme[j]; !isAlphanum(scheme[j:j+1]) && c != '+' && c != '-' && c != '.' {
+				return fail("scheme", j)
+			}
+		}
+		rest, offset = rest[i+1:], i+1
+	}
+	if i := strings.IndexByte(rest, '#'); i >= 0 {
+		if j := strings.IndexAny(rest[i+1:], "#[]"); j >= 0 {
+			return fail("fragment", offset+i+1+j)
+		}
+		rest = rest[:i]
+	}
+	if i := strings.IndexByte(rest, '?'); i >= 0 {
+		if j := strings.IndexAny(rest[i+1:], "[]"); j >= 0 {
+			return fail("query", offset+i+1+j)
+		}
+		rest = rest[:i]
+	}
+	if strings.HasPrefix(rest, "//") {
+		authority := rest[2:]
+		if i := strings.IndexByte(authority, '/'); i >= 0 {
+			authority, rest = authority[:i], authority[i:]
+		} else {
+			rest = ""
+		}
+		offset += 2
+		if i := strings.LastIndexByte(authority, '@'); i >= 0 {
+			if strings.ContainsAny(authority[:i], "[]@") {
+				return fail("userinfo", offset)
+			}
+			authority, offset = authority[i+1:], offset+i+1
+		}
+		host, port := authority, ""
+		if strings.HasPrefix(host, "[") {
+			end := strings.IndexByte(host, ']')
+			if end < 0 {
+				return fail("IP-literal", offset)
+			}
+			host, port = host[:end+1], host[end+1:]
+			if len(port) > 0 && port[0] != ':' {
+				return fail("port", offset+end+1)
+			}
+		} else if i := strings.LastIndexByte(host, ':'); i >= 0 {
+			host, port = host[:i], host[i:]
+		}
+		if strings.ContainsAny(strings.Trim(host, "[]"), "[]") {
+			return fail("host", offset)
+		}
+		if len(port) > 0 && !isDigits(port[1:]) {
+			return fail("port", offset+len(host))
+		}
+	} else if offset == 0 {
+		//	A relative-path reference may not have a colon in its first segment, as it would read as a scheme.
+		first := rest
+		if i := strings.IndexByte(first, '/'); i >= 0 {
+			first = first[:i]
+		}
+		if strings.IndexByte(first, ':') >= 0 {
+			return fail("relative-part (colon in first segment)", strings.IndexByte(first, ':'))
+		}
+	}
+	if i := strings.IndexAny(rest, "[]"); i >= 0 {
+		return fail("path", offset+i)
+	}
+	return nil
+}
+
+//	Reports whether Validate succeeds.
+func (me AnyURI) IsValid() bool {
+	return me.Validate() == nil
+}
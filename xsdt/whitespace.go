@@ -0,0 +1,61 @@
+package xsdt
+
+import (
+	"strings"
+)
+
+//	The XSD whiteSpace facet, which says how whitespace in a lexical value is normalized before it is interpreted.
+type WhiteSpace int
+
+const (
+	//	No normalization is done; the value is kept as is. This is the facet of xs:string.
+	WhiteSpacePreserve WhiteSpace = iota
+
+	//	Every tab, line feed and carriage return is replaced by a space. This is the facet of xs:normalizedString.
+	WhiteSpaceReplace
+
+	//	After replacing, runs of spaces are collapsed to a single space and leading and trailing spaces are removed.
+	//	This is the facet of xs:token, everything derived from it, and all non-string built-in types.
+	WhiteSpaceCollapse
+)
+
+//	Returns the facet value as written in a schema: "preserve", "replace" or "collapse".
+func (me WhiteSpace) String() string {
+	switch me {
+	case WhiteSpaceReplace:
+		return "replace"
+	case WhiteSpaceCollapse:
+		return "collapse"
+	}
+	return "preserve"
+}
+
+//	Normalizes s according to this facet value.
+func (me WhiteSpace) Apply(s string) string {
+	switch me {
+	case WhiteSpaceReplace:
+		return ReplaceWhiteSpace(s)
+	case WhiteSpaceCollapse:
+		return CollapseWhiteSpace(s)
+	}
+	return s
+}
+
+//	Applies the whiteSpace="replace" facet to s: every tab, line feed and carriage return becomes a space.
+func ReplaceWhiteSpace(s string) string {
+	if strings.IndexAny(s, "\t\n\r") < 0 {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		if isXsdWhitespace(r) {
+			return ' '
+		}
+		return r
+	}, s)
+}
+
+//	Applies the whiteSpace="collapse" facet to s: runs of XSD whitespace become a single space, and leading and
+//	trailing whitespace is removed. Unlike strings.Fields, other Unicode spaces such as U+00A0 are left alone.
+func CollapseWhiteSpace(s string) string {
+	return strings.Join(strings.FieldsFunc(s, isXsdWhitespace), " ")
+}
@@ -1,7 +1,10 @@
 package xsdt
 
 import (
+	"math"
+	"math/big"
 	"strconv"
+	"strings"
 )
 
 type notation struct {
@@ -53,9 +56,9 @@ type ToXsdtAnyType interface {
 //	Represents a URI as defined by RFC 2396. An anyURI value can be absolute or relative, and may have an optional fragment identifier.
 type AnyURI string
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="collapse" facet of this type.
 func (me *AnyURI) Set(v string) {
-	*me = AnyURI(v)
+	*me = AnyURI(CollapseWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -69,7 +72,8 @@ type ToXsdtAnyURI interface {
 }
 
 //	Represents Base64-encoded arbitrary binary data. A base64Binary is the set of finite-length sequences of binary octets.
-type Base64Binary string // []byte
+//	The encoded form is kept as is; use Bytes for the decoded octets, or NewBase64Reader and DecodeBase64Element for large payloads.
+type Base64Binary string
 
 //	Since this is just a simple String type, this merely sets the current value from the specified string.
 func (me *Base64Binary) Set(v string) {
@@ -108,6 +112,19 @@ func (me *Boolean) Set(v string) {
 	}
 }
 
+//	Like Set, but returns an error unless the specified string is one of the xs:boolean lexical values "true", "false", "1" and "0", in which case the current value is left unchanged.
+func (me *Boolean) Parse(s string) error {
+	switch trimXsdWhitespace(s) {
+	case "true", "1":
+		*me = true
+	case "false", "0":
+		*me = false
+	default:
+		return &ParseError{Type: "boolean", Value: s, Err: ErrSyntax}
+	}
+	return nil
+}
+
 //	Returns a string representation of its current non-string scalar value.
 func (me Boolean) String() string {
 	return strconv.FormatBool(bool(me))
@@ -132,6 +149,15 @@ func (me *Byte) Set(s string) {
 	*me = Byte(v)
 }
 
+//	Like Set, but returns an error if the specified string is not a valid xs:byte lexical value or lies outside its value space, in which case the current value is left unchanged.
+func (me *Byte) Parse(s string) error {
+	v, err := parseSigned("byte", s, 8, math.MinInt8, math.MaxInt8)
+	if err == nil {
+		*me = Byte(v)
+	}
+	return err
+}
+
 //	Returns a string representation of its current non-string scalar value.
 func (me Byte) String() string {
 	return strconv.FormatInt(int64(me), 10)
@@ -144,7 +170,8 @@ type ToXsdtByte interface {
 
 //	Represents a calendar date.
 //	The pattern for date is CCYY-MM-DD with optional time zone indicator as allowed for dateTime.
-type Date string // time.Time
+//	The lexical form is kept as is; use Value or Time for the parsed value.
+type Date string
 
 //	Since this is just a simple String type, this merely sets the current value from the specified string.
 func (me *Date) Set(v string) {
@@ -162,7 +189,8 @@ type ToXsdtDate interface {
 }
 
 //	Represents a specific instance of time.
-type DateTime string // time.Time
+//	The lexical form is kept as is; use Value or Time for the parsed value.
+type DateTime string
 
 //	Since this is just a simple String type, this merely sets the current value from the specified string.
 func (me *DateTime) Set(v string) {
@@ -179,8 +207,9 @@ type ToXsdtDateTime interface {
 	ToXsdtDateTime() DateTime
 }
 
-//	Represents a specific instance of time.
-type Time string // time.Time
+//	Represents an instant of time that recurs every day.
+//	The lexical form is kept as is; use Value or Time for the parsed value.
+type Time string
 
 //	Since this is just a simple String type, this merely sets the current value from the specified string.
 func (me *Time) Set(v string) {
@@ -197,17 +226,46 @@ type ToXsdtTime interface {
 	ToXsdtTime() Time
 }
 
-//	Represents arbitrary precision numbers.
-type Decimal string // complex128
+//	Represents arbitrary precision numbers, held exactly as a *big.Rat.
+//	The zero value has no value: it prints as "" and is left out when marshaling.
+type Decimal struct {
+	r *big.Rat
+}
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string. An invalid string leaves the Decimal without value; use Parse to get the error.
 func (me *Decimal) Set(v string) {
-	*me = Decimal(v)
+	if me.Parse(v) != nil {
+		*me = Decimal{}
+	}
 }
 
-//	Since this is just a simple String type, this merely returns its current string value.
+//	Like Set, but returns an error if the specified string is not a valid xs:decimal lexical value, in which case the current value is left unchanged.
+func (me *Decimal) Parse(s string) error {
+	v := trimXsdWhitespace(s)
+	if !isDecimalLexical(v) {
+		return &ParseError{Type: "decimal", Value: s, Err: ErrSyntax}
+	}
+	neg := strings.HasPrefix(v, "-")
+	v = strings.TrimLeft(v, "+-")
+	intPart, fracPart, _ := strings.Cut(v, ".")
+	num, _ := new(big.Int).SetString(intPart+fracPart, 10)
+	if neg {
+		num.Neg(num)
+	}
+	den := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(fracPart))), nil)
+	me.r = new(big.Rat).SetFrac(num, den)
+	return nil
+}
+
+//	Returns the current value in its canonical lexical form (as of XSD 1.1): no "+" sign, no superfluous
+//	leading or trailing zeros, and no decimal point for integral values, e.g. "+01.50" becomes "1.5".
+//	Returns "" if the Decimal has no value.
 func (me Decimal) String() string {
-	return string(me)
+	if me.r == nil {
+		return ""
+	}
+	digits, _ := decimalPlaces(me.r)
+	return me.r.FloatString(digits)
 }
 
 //	A convenience interface that declares a type conversion to Decimal.
@@ -223,15 +281,25 @@ func (me Double) N() float64 {
 	return float64(me)
 }
 
-//	Since this is a non-string scalar type, sets its current value obtained from parsing the specified string.
+//	Since this is a non-string scalar type, sets its current value obtained from parsing the specified string,
+//	which may be one of the special values "INF", "-INF" and "NaN". Values too large in magnitude become INF or
+//	-INF; invalid strings set the value to zero.
 func (me *Double) Set(s string) {
-	v, _ := strconv.ParseFloat(s, 64)
-	*me = Double(v)
+	*me = Double(setFloat("double", s, 64))
 }
 
-//	Returns a string representation of its current non-string scalar value.
+//	Like Set, but returns an error if the specified string is not a valid xs:double lexical value or lies outside its value space, in which case the current value is left unchanged.
+func (me *Double) Parse(s string) error {
+	v, err := parseFloat("double", s, 64)
+	if err == nil {
+		*me = Double(v)
+	}
+	return err
+}
+
+//	Returns the canonical xs:double representation of its current value, e.g. "1.0E6", "-0.0E0" or "INF".
 func (me Double) String() string {
-	return strconv.FormatFloat(float64(me), 'f', 8, 64)
+	return formatFloat(float64(me), 64)
 }
 
 //	A convenience interface that declares a type conversion to Double.
@@ -240,7 +308,8 @@ type ToXsdtDouble interface {
 }
 
 //	Represents a duration of time.
-type Duration string // time.Duration
+//	The lexical form is kept as is; use Value for the parsed value.
+type Duration string
 
 //	Since this is just a simple String type, this merely sets the current value from the specified string.
 func (me *Duration) Set(v string) {
@@ -260,9 +329,9 @@ type ToXsdtDuration interface {
 //	Represents the ENTITIES attribute type. Contains a set of values of type ENTITY.
 type Entities string
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="collapse" facet of this type.
 func (me *Entities) Set(v string) {
-	*me = Entities(v)
+	*me = Entities(CollapseWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -288,9 +357,9 @@ type ToXsdtEntities interface {
 //	This is a reference to an unparsed entity with a name that matches the specified name.
 type Entity NCName
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="collapse" facet of this type.
 func (me *Entity) Set(v string) {
-	*me = Entity(v)
+	*me = Entity(CollapseWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -311,15 +380,25 @@ func (me Float) N() float32 {
 	return float32(me)
 }
 
-//	Since this is a non-string scalar type, sets its current value obtained from parsing the specified string.
+//	Since this is a non-string scalar type, sets its current value obtained from parsing the specified string,
+//	which may be one of the special values "INF", "-INF" and "NaN". Values too large in magnitude become INF or
+//	-INF; invalid strings set the value to zero.
 func (me *Float) Set(s string) {
-	v, _ := strconv.ParseFloat(s, 32)
-	*me = Float(v)
+	*me = Float(setFloat("float", s, 32))
 }
 
-//	Returns a string representation of its current non-string scalar value.
+//	Like Set, but returns an error if the specified string is not a valid xs:float lexical value or lies outside its value space, in which case the current value is left unchanged.
+func (me *Float) Parse(s string) error {
+	v, err := parseFloat("float", s, 32)
+	if err == nil {
+		*me = Float(v)
+	}
+	return err
+}
+
+//	Returns the canonical xs:float representation of its current value, e.g. "1.0E6", "-0.0E0" or "INF".
 func (me Float) String() string {
-	return strconv.FormatFloat(float64(me), 'f', 8, 32)
+	return formatFloat(float64(me), 32)
 }
 
 //	A convenience interface that declares a type conversion to Float.
@@ -418,7 +497,8 @@ type ToXsdtGYearMonth interface {
 }
 
 //	Represents arbitrary hex-encoded binary data. A hexBinary is the set of finite-length sequences of binary octets. Each binary octet is encoded as a character tuple, consisting of two hexadecimal digits ([0-9a-fA-F]) representing the octet code.
-type HexBinary string // []byte
+//	The encoded form is kept as is; use Bytes for the decoded octets, or NewHexReader and DecodeHexElement for large payloads.
+type HexBinary string
 
 //	Since this is just a simple String type, this merely sets the current value from the specified string.
 func (me *HexBinary) Set(v string) {
@@ -438,9 +518,9 @@ type ToXsdtHexBinary interface {
 //	The ID must be a no-colon-name (NCName) and must be unique within an XML document.
 type Id NCName
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="collapse" facet of this type.
 func (me *Id) Set(v string) {
-	*me = Id(v)
+	*me = Id(CollapseWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -456,9 +536,9 @@ type ToXsdtId interface {
 //	Represents a reference to an element that has an ID attribute that matches the specified ID. An IDREF must be an NCName and must be a value of an element or attribute of type ID within the XML document.
 type Idref NCName
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="collapse" facet of this type.
 func (me *Idref) Set(v string) {
-	*me = Idref(v)
+	*me = Idref(CollapseWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -474,9 +554,9 @@ type ToXsdtIdref interface {
 //	Contains a set of values of type IDREF.
 type Idrefs string
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="collapse" facet of this type.
 func (me *Idrefs) Set(v string) {
-	*me = Idrefs(v)
+	*me = Idrefs(CollapseWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -513,6 +593,15 @@ func (me *Int) Set(s string) {
 	*me = Int(v)
 }
 
+//	Like Set, but returns an error if the specified string is not a valid xs:int lexical value or lies outside its value space, in which case the current value is left unchanged.
+func (me *Int) Parse(s string) error {
+	v, err := parseSigned("int", s, 32, math.MinInt32, math.MaxInt32)
+	if err == nil {
+		*me = Int(v)
+	}
+	return err
+}
+
 //	Returns a string representation of its current non-string scalar value.
 func (me Int) String() string {
 	return strconv.FormatInt(int64(me), 10)
@@ -524,22 +613,49 @@ type ToXsdtInt interface {
 }
 
 //	Represents a sequence of decimal digits with an optional leading sign (+ or -).
-type Integer int64
+//	XSD puts no bound on its value, so it is held as a *big.Int.
+//	The zero value has no value: it prints as "" and is left out when marshaling.
+type Integer struct {
+	n *big.Int
+}
 
 //	Because littering your code with type conversions is a hassle...
-func (me Integer) N() int64 {
-	return int64(me)
+//	Returns the current value and true, or 0 and false if it is unset or does not fit an int64; use Big for such values.
+func (me Integer) N() (int64, bool) {
+	if me.n != nil && me.n.IsInt64() {
+		return me.n.Int64(), true
+	}
+	return 0, false
 }
 
-//	Since this is a non-string scalar type, sets its current value obtained from parsing the specified string.
-func (me *Integer) Set(s string) {
-	v, _ := strconv.ParseInt(s, 0, 64)
-	*me = Integer(v)
+//	Sets the current value from the specified string. An invalid string leaves the Integer without value; use Parse to get the error.
+func (me *Integer) Set(v string) {
+	if me.Parse(v) != nil {
+		*me = Integer{}
+	}
 }
 
-//	Returns a string representation of its current non-string scalar value.
+//	Like Set, but returns an error if the specified string is not a valid xs:integer lexical value, in which case the current value is left unchanged.
+func (me *Integer) Parse(s string) error {
+	neg, digits, ok := splitIntegerLexical(trimXsdWhitespace(s))
+	if !ok {
+		return &ParseError{Type: "integer", Value: s, Err: ErrSyntax}
+	}
+	n, _ := new(big.Int).SetString(digits, 10)
+	if neg {
+		n.Neg(n)
+	}
+	me.n = n
+	return nil
+}
+
+//	Returns the current value in its canonical lexical form: no "+" sign and no leading zeros.
+//	Returns "" if the Integer has no value.
 func (me Integer) String() string {
-	return strconv.FormatInt(int64(me), 10)
+	if me.n == nil {
+		return ""
+	}
+	return me.n.String()
 }
 
 //	A convenience interface that declares a type conversion to Integer.
@@ -550,9 +666,9 @@ type ToXsdtInteger interface {
 //	Represents natural language identifiers (defined by RFC 1766).
 type Language Token
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="collapse" facet of this type.
 func (me *Language) Set(v string) {
-	*me = Language(v)
+	*me = Language(CollapseWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -579,6 +695,15 @@ func (me *Long) Set(s string) {
 	*me = Long(v)
 }
 
+//	Like Set, but returns an error if the specified string is not a valid xs:long lexical value or lies outside its value space, in which case the current value is left unchanged.
+func (me *Long) Parse(s string) error {
+	v, err := parseSigned("long", s, 64, math.MinInt64, math.MaxInt64)
+	if err == nil {
+		*me = Long(v)
+	}
+	return err
+}
+
 //	Returns a string representation of its current non-string scalar value.
 func (me Long) String() string {
 	return strconv.FormatInt(int64(me), 10)
@@ -592,9 +717,9 @@ type ToXsdtLong interface {
 //	Represents names in XML. A Name is a token that begins with a letter, underscore, or colon and continues with name characters (letters, digits, and other characters).
 type Name Token
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="collapse" facet of this type.
 func (me *Name) Set(v string) {
-	*me = Name(v)
+	*me = Name(CollapseWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -610,9 +735,9 @@ type ToXsdtName interface {
 //	Represents noncolonized names. This data type is the same as Name, except it cannot begin with a colon.
 type NCName Name
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="collapse" facet of this type.
 func (me *NCName) Set(v string) {
-	*me = NCName(v)
+	*me = NCName(CollapseWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -639,6 +764,15 @@ func (me *NegativeInteger) Set(s string) {
 	*me = NegativeInteger(v)
 }
 
+//	Like Set, but returns an error if the specified string is not a valid xs:negativeInteger lexical value or lies outside its value space, in which case the current value is left unchanged.
+func (me *NegativeInteger) Parse(s string) error {
+	v, err := parseSigned("negativeInteger", s, 64, math.MinInt64, -1)
+	if err == nil {
+		*me = NegativeInteger(v)
+	}
+	return err
+}
+
 //	Returns a string representation of its current non-string scalar value.
 func (me NegativeInteger) String() string {
 	return strconv.FormatInt(int64(me), 10)
@@ -652,9 +786,9 @@ type ToXsdtNegativeInteger interface {
 //	An NMTOKEN is set of name characters (letters, digits, and other characters) in any combination. Unlike Name and NCName, NMTOKEN has no restrictions on the starting character.
 type Nmtoken Token
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="collapse" facet of this type.
 func (me *Nmtoken) Set(v string) {
-	*me = Nmtoken(v)
+	*me = Nmtoken(CollapseWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -670,9 +804,9 @@ type ToXsdtNmtoken interface {
 //	Contains a set of values of type NMTOKEN.
 type Nmtokens string
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="collapse" facet of this type.
 func (me *Nmtokens) Set(v string) {
-	*me = Nmtokens(v)
+	*me = Nmtokens(CollapseWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -709,6 +843,15 @@ func (me *NonNegativeInteger) Set(s string) {
 	*me = NonNegativeInteger(v)
 }
 
+//	Like Set, but returns an error if the specified string is not a valid xs:nonNegativeInteger lexical value or lies outside its value space, in which case the current value is left unchanged.
+func (me *NonNegativeInteger) Parse(s string) error {
+	v, err := parseUnsigned("nonNegativeInteger", s, 64, 0)
+	if err == nil {
+		*me = NonNegativeInteger(v)
+	}
+	return err
+}
+
 //	Returns a string representation of its current non-string scalar value.
 func (me NonNegativeInteger) String() string {
 	return strconv.FormatUint(uint64(me), 10)
@@ -733,6 +876,15 @@ func (me *NonPositiveInteger) Set(s string) {
 	*me = NonPositiveInteger(v)
 }
 
+//	Like Set, but returns an error if the specified string is not a valid xs:nonPositiveInteger lexical value or lies outside its value space, in which case the current value is left unchanged.
+func (me *NonPositiveInteger) Parse(s string) error {
+	v, err := parseSigned("nonPositiveInteger", s, 64, math.MinInt64, 0)
+	if err == nil {
+		*me = NonPositiveInteger(v)
+	}
+	return err
+}
+
 //	Returns a string representation of its current non-string scalar value.
 func (me NonPositiveInteger) String() string {
 	return strconv.FormatInt(int64(me), 10)
@@ -746,9 +898,9 @@ type ToXsdtNonPositiveInteger interface {
 //	Represents white space normalized strings.
 type NormalizedString String
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="replace" facet of this type.
 func (me *NormalizedString) Set(v string) {
-	*me = NormalizedString(v)
+	*me = NormalizedString(ReplaceWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -764,9 +916,9 @@ type ToXsdtNormalizedString interface {
 //	A set of QNames.
 type Notation string
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="collapse" facet of this type.
 func (me *Notation) Set(v string) {
-	*me = Notation(v)
+	*me = Notation(CollapseWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -803,6 +955,15 @@ func (me *PositiveInteger) Set(s string) {
 	*me = PositiveInteger(v)
 }
 
+//	Like Set, but returns an error if the specified string is not a valid xs:positiveInteger lexical value or lies outside its value space, in which case the current value is left unchanged.
+func (me *PositiveInteger) Parse(s string) error {
+	v, err := parseUnsigned("positiveInteger", s, 64, 1)
+	if err == nil {
+		*me = PositiveInteger(v)
+	}
+	return err
+}
+
 //	Returns a string representation of its current non-string scalar value.
 func (me PositiveInteger) String() string {
 	return strconv.FormatUint(uint64(me), 10)
@@ -816,9 +977,9 @@ type ToXsdtPositiveInteger interface {
 //	Represents a qualified name. A qualified name is composed of a prefix and a local name separated by a colon. Both the prefix and local names must be an NCName. The prefix must be associated with a namespace URI reference, using a namespace declaration.
 type Qname string
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="collapse" facet of this type.
 func (me *Qname) Set(v string) {
-	*me = Qname(v)
+	*me = Qname(CollapseWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -845,6 +1006,15 @@ func (me *Short) Set(s string) {
 	*me = Short(v)
 }
 
+//	Like Set, but returns an error if the specified string is not a valid xs:short lexical value or lies outside its value space, in which case the current value is left unchanged.
+func (me *Short) Parse(s string) error {
+	v, err := parseSigned("short", s, 16, math.MinInt16, math.MaxInt16)
+	if err == nil {
+		*me = Short(v)
+	}
+	return err
+}
+
 //	Returns a string representation of its current non-string scalar value.
 func (me Short) String() string {
 	return strconv.FormatInt(int64(me), 10)
@@ -876,9 +1046,9 @@ type ToXsdtString interface {
 //	Represents tokenized strings.
 type Token NormalizedString
 
-//	Since this is just a simple String type, this merely sets the current value from the specified string.
+//	Sets the current value from the specified string, after applying the whiteSpace="collapse" facet of this type.
 func (me *Token) Set(v string) {
-	*me = Token(v)
+	*me = Token(CollapseWhiteSpace(v))
 }
 
 //	Since this is just a simple String type, this merely returns its current string value.
@@ -905,6 +1075,15 @@ func (me *UnsignedByte) Set(s string) {
 	*me = UnsignedByte(v)
 }
 
+//	Like Set, but returns an error if the specified string is not a valid xs:unsignedByte lexical value or lies outside its value space, in which case the current value is left unchanged.
+func (me *UnsignedByte) Parse(s string) error {
+	v, err := parseUnsigned("unsignedByte", s, 8, 0)
+	if err == nil {
+		*me = UnsignedByte(v)
+	}
+	return err
+}
+
 //	Returns a string representation of its current non-string scalar value.
 func (me UnsignedByte) String() string {
 	return strconv.FormatUint(uint64(me), 10)
@@ -929,6 +1108,15 @@ func (me *UnsignedInt) Set(s string) {
 	*me = UnsignedInt(v)
 }
 
+//	Like Set, but returns an error if the specified string is not a valid xs:unsignedInt lexical value or lies outside its value space, in which case the current value is left unchanged.
+func (me *UnsignedInt) Parse(s string) error {
+	v, err := parseUnsigned("unsignedInt", s, 32, 0)
+	if err == nil {
+		*me = UnsignedInt(v)
+	}
+	return err
+}
+
 //	Returns a string representation of its current non-string scalar value.
 func (me UnsignedInt) String() string {
 	return strconv.FormatUint(uint64(me), 10)
@@ -953,6 +1141,15 @@ func (me *UnsignedLong) Set(s string) {
 	*me = UnsignedLong(v)
 }
 
+//	Like Set, but returns an error if the specified string is not a valid xs:unsignedLong lexical value or lies outside its value space, in which case the current value is left unchanged.
+func (me *UnsignedLong) Parse(s string) error {
+	v, err := parseUnsigned("unsignedLong", s, 64, 0)
+	if err == nil {
+		*me = UnsignedLong(v)
+	}
+	return err
+}
+
 //	Returns a string representation of its current non-string scalar value.
 func (me UnsignedLong) String() string {
 	return strconv.FormatUint(uint64(me), 10)
@@ -977,6 +1174,15 @@ func (me *UnsignedShort) Set(s string) {
 	*me = UnsignedShort(v)
 }
 
+//	Like Set, but returns an error if the specified string is not a valid xs:unsignedShort lexical value or lies outside its value space, in which case the current value is left unchanged.
+func (me *UnsignedShort) Parse(s string) error {
+	v, err := parseUnsigned("unsignedShort", s, 16, 0)
+	if err == nil {
+		*me = UnsignedShort(v)
+	}
+	return err
+}
+
 //	Returns a string representation of its current non-string scalar value.
 func (me UnsignedShort) String() string {
 	return strconv.FormatUint(uint64(me), 10)
@@ -0,0 +1,791 @@
+package xsdt
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type parser interface {
+	Parse(string) error
+	String() string
+}
+
+var parseTests = []struct {
+	Value  parser
+	Input  string
+	Expect string
+	Err    error
+}{
+	{Value: new(Byte), Input: "127", Expect: "127"},
+	{Value: new(Byte), Input: "+5", Expect: "5"},
+	{Value: new(Byte), Input: " -128\n", Expect: "-128"},
+	{Value: new(Byte), Input: "128", Err: ErrRange},
+	{Value: new(Byte), Input: "abc", Err: ErrSyntax},
+	{Value: new(Byte), Input: "0x10", Err: ErrSyntax},
+	{Value: new(Short), Input: "-32769", Err: ErrRange},
+	{Value: new(Int), Input: "007", Expect: "7"},
+	{Value: new(Int), Input: "1 000", Err: ErrSyntax},
+	{Value: new(Long), Input: "9223372036854775808", Err: ErrRange},
+	{Value: new(Integer), Input: "", Err: ErrSyntax},
+	{Value: new(Integer), Input: "+", Err: ErrSyntax},
+	{Value: new(Integer), Input: " -0009223372036854775809", Expect: "-9223372036854775809"},
+	{Value: new(PositiveInteger), Input: "0", Err: ErrRange},
+	{Value: new(PositiveInteger), Input: "+1", Expect: "1"},
+	{Value: new(PositiveInteger), Input: "-1", Err: ErrRange},
+	{Value: new(NonNegativeInteger), Input: "-0", Expect: "0"},
+	{Value: new(NegativeInteger), Input: "0", Err: ErrRange},
+	{Value: new(NegativeInteger), Input: "-3", Expect: "-3"},
+	{Value: new(NonPositiveInteger), Input: "1", Err: ErrRange},
+	{Value: new(UnsignedByte), Input: "256", Err: ErrRange},
+	{Value: new(UnsignedShort), Input: "65535", Expect: "65535"},
+	{Value: new(UnsignedInt), Input: "-5", Err: ErrRange},
+	{Value: new(UnsignedLong), Input: "18446744073709551615", Expect: "18446744073709551615"},
+	{Value: new(Decimal), Input: "-.5", Expect: "-0.5"},
+	{Value: new(Decimal), Input: "1.", Expect: "1"},
+	{Value: new(Decimal), Input: ".", Err: ErrSyntax},
+	{Value: new(Decimal), Input: "1e3", Err: ErrSyntax},
+	{Value: new(Double), Input: "inf", Err: ErrSyntax},
+	{Value: new(Double), Input: "0x1p-2", Err: ErrSyntax},
+	{Value: new(Double), Input: "1e", Err: ErrSyntax},
+	{Value: new(Float), Input: "1e39", Err: ErrRange},
+}
+
+func TestParse(t *testing.T) {
+	for idx, test := range parseTests {
+		err := test.Value.Parse(test.Input)
+		if test.Err != nil {
+			var perr *ParseError
+			if !errors.Is(err, test.Err) || !errors.As(err, &perr) {
+				t.Errorf("#%d: Parse(%q): have error %v, want %v", idx, test.Input, err, test.Err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("#%d: Parse(%q): unexpected error: %s", idx, test.Input, err)
+		} else if got, want := test.Value.String(), test.Expect; got != want {
+			t.Errorf("#%d: Parse(%q): have %q, want %q", idx, test.Input, got, want)
+		}
+	}
+}
+
+func TestParseFloatSpecials(t *testing.T) {
+	var d Double
+	if err := d.Parse("INF"); err != nil || !math.IsInf(d.N(), 1) {
+		t.Errorf("INF: have %v (%v)", d, err)
+	}
+	if err := d.Parse("-INF"); err != nil || !math.IsInf(d.N(), -1) {
+		t.Errorf("-INF: have %v (%v)", d, err)
+	}
+	if err := d.Parse("NaN"); err != nil || !math.IsNaN(d.N()) {
+		t.Errorf("NaN: have %v (%v)", d, err)
+	}
+	d = 42
+	if err := d.Parse("nan"); err == nil || d != 42 {
+		t.Errorf("nan: have %v (%v), want error and unchanged value", d, err)
+	}
+}
+
+func TestFloatCanonical(t *testing.T) {
+	tests := []struct {
+		in, double, float string
+	}{
+		{"1e6", "1.0E6", "1.0E6"},
+		{"1000000", "1.0E6", "1.0E6"},
+		{"-0.00125", "-1.25E-3", "-1.25E-3"},
+		{"0.1", "1.0E-1", "1.0E-1"},
+		{"123.456", "1.23456E2", "1.23456E2"},
+		{"3.0", "3.0E0", "3.0E0"},
+		{"0", "0.0E0", "0.0E0"},
+		{"-0", "-0.0E0", "-0.0E0"},
+		{"-0.0e5", "-0.0E0", "-0.0E0"},
+		{"1e-400", "0.0E0", "0.0E0"},
+		{"1.7976931348623157E308", "1.7976931348623157E308", "INF"},
+		{"+INF", "INF", "INF"},
+		{"-INF", "-INF", "-INF"},
+		{"-1e999", "-INF", "-INF"},
+		{"1,5", "0.0E0", "0.0E0"},
+		{" NaN ", "NaN", "NaN"},
+	}
+	for _, test := range tests {
+		var d Double
+		var f Float
+		d.Set(test.in)
+		f.Set(test.in)
+		if d.String() != test.double {
+			t.Errorf("Double(%q): have %s, want %s", test.in, d, test.double)
+		}
+		if f.String() != test.float {
+			t.Errorf("Float(%q): have %s, want %s", test.in, f, test.float)
+		}
+		if err := d.Parse(d.String()); err != nil || (d.String() != test.double) {
+			t.Errorf("Double(%q) does not round-trip: %v", test.in, err)
+		}
+	}
+	var f Float
+	if err := f.Parse("1.7976931348623157E308"); !errors.Is(err, ErrRange) {
+		t.Errorf("Float.Parse: expected ErrRange, have %v", err)
+	}
+	if f = Float(0.1); f.String() != "1.0E-1" {
+		t.Errorf("Float(0.1): have %s, want the shortest float32 digits", f)
+	}
+	out, _ := xml.Marshal(struct {
+		XMLName xml.Name `xml:"measurement"`
+		Value   Double   `xml:"value,attr"`
+	}{Value: 1e6})
+	if string(out) != `<measurement value="1.0E6"></measurement>` {
+		t.Errorf("Marshal: have %s", out)
+	}
+}
+
+var dateTimeTests = []struct {
+	Kind   int
+	Input  string
+	Expect string
+	Err    error
+}{
+	{Kind: dtDateTime, Input: "2002-10-10T12:00:00-05:00", Expect: "2002-10-10T12:00:00-05:00"},
+	{Kind: dtDateTime, Input: "2002-10-10T12:00:00.500Z", Expect: "2002-10-10T12:00:00.5Z"},
+	{Kind: dtDateTime, Input: "1999-12-31T24:00:00", Expect: "2000-01-01T00:00:00"},
+	{Kind: dtDateTime, Input: "1999-12-31T24:00:01", Err: ErrRange},
+	{Kind: dtDateTime, Input: "-0044-03-15T12:00:00", Expect: "-0044-03-15T12:00:00"},
+	{Kind: dtDateTime, Input: "2002-10-10 12:00:00", Err: ErrSyntax},
+	{Kind: dtDateTime, Input: "2002-10-10T12:00:00+14:30", Err: ErrSyntax},
+	{Kind: dtDate, Input: "12004-02-29", Expect: "12004-02-29"},
+	{Kind: dtDate, Input: "02004-02-29", Err: ErrSyntax},
+	{Kind: dtDate, Input: "2003-02-29", Err: ErrRange},
+	{Kind: dtDate, Input: "0000-01-01Z", Expect: "0000-01-01Z"},
+	{Kind: dtTime, Input: "13:20:00+00:00", Expect: "13:20:00Z"},
+	{Kind: dtTime, Input: "24:00:00", Expect: "00:00:00"},
+	{Kind: dtGYearMonth, Input: "1999-05", Expect: "1999-05"},
+	{Kind: dtGYear, Input: "-1650", Expect: "-1650"},
+	{Kind: dtGYear, Input: "165", Err: ErrSyntax},
+	{Kind: dtGMonthDay, Input: "--02-29", Expect: "--02-29"},
+	{Kind: dtGMonthDay, Input: "--04-31", Err: ErrRange},
+	{Kind: dtGDay, Input: "---05Z", Expect: "---05Z"},
+	{Kind: dtGMonth, Input: "--13", Err: ErrRange},
+}
+
+func TestDateTimeValue(t *testing.T) {
+	for idx, test := range dateTimeTests {
+		v, err := parseDateTimeValue(test.Kind, test.Input)
+		if test.Err != nil {
+			if !errors.Is(err, test.Err) {
+				t.Errorf("#%d: parse(%q): have error %v, want %v", idx, test.Input, err, test.Err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("#%d: parse(%q): unexpected error: %s", idx, test.Input, err)
+		} else if got, want := formatDateTimeValue(test.Kind, v), test.Expect; got != want {
+			t.Errorf("#%d: parse(%q): have %q, want %q", idx, test.Input, got, want)
+		}
+	}
+}
+
+func TestDateTimeCompare(t *testing.T) {
+	tests := []struct {
+		A, B   DateTime
+		Expect int
+		Err    error
+	}{
+		{"2002-10-10T12:00:00-05:00", "2002-10-10T17:00:00Z", 0, nil},
+		{"2002-10-10T12:00:00-05:00", "2002-10-10T13:00:00Z", 1, nil},
+		{"2000-01-15T00:00:00", "2000-02-15T00:00:00Z", -1, nil},
+		{"2000-01-01T12:00:00", "1999-12-31T23:00:00Z", 0, ErrIndeterminate},
+	}
+	for idx, test := range tests {
+		got, err := test.A.Compare(test.B)
+		if err != test.Err || got != test.Expect {
+			t.Errorf("#%d: %s.Compare(%s): have %d (%v), want %d (%v)", idx, test.A, test.B, got, err, test.Expect, test.Err)
+		}
+	}
+}
+
+func TestDateTimeTimeError(t *testing.T) {
+	if tm, err := DateTime("2002-13-10T12:00:00").Time(); err == nil || !tm.IsZero() {
+		t.Errorf("DateTime.Time: have %v (%v), want zero time and error", tm, err)
+	}
+	if tm, err := Date("2002-10-32").Time(); err == nil || !tm.IsZero() {
+		t.Errorf("Date.Time: have %v (%v), want zero time and error", tm, err)
+	}
+	if tm, err := Time("25:00:00").Time(); err == nil || !tm.IsZero() {
+		t.Errorf("Time.Time: have %v (%v), want zero time and error", tm, err)
+	}
+}
+
+func TestDateTimeValueTimeMissingDay(t *testing.T) {
+	tests := []struct {
+		Input  GYearMonth
+		Expect string
+	}{
+		{"2021-02", "2021-02-28"},
+		{"2020-02", "2020-02-29"},
+		{"2021-04", "2021-04-30"},
+		{"2021-12", "2021-12-31"},
+	}
+	for _, test := range tests {
+		v, err := test.Input.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have := v.Time().Format("2006-01-02"); have != test.Expect {
+			t.Errorf("%s: have %s, want %s", test.Input, have, test.Expect)
+		}
+	}
+}
+
+var durationTests = []struct {
+	Input     string
+	Canonical string
+	Err       error
+}{
+	{Input: "P1Y2M3DT10H30M", Canonical: "P1Y2M3DT10H30M"},
+	{Input: "-P120D", Canonical: "-P120D"},
+	{Input: "PT36H", Canonical: "P1DT12H"},
+	{Input: "P13M", Canonical: "P1Y1M"},
+	{Input: "PT1.500S", Canonical: "PT1.5S"},
+	{Input: "PT.5S", Canonical: "PT0.5S"},
+	{Input: "-PT0S", Canonical: "PT0S"},
+	{Input: "P", Err: ErrSyntax},
+	{Input: "P1DT", Err: ErrSyntax},
+	{Input: "P1.5D", Err: ErrSyntax},
+	{Input: "P1M1Y", Err: ErrSyntax},
+	{Input: "PT1S1M", Err: ErrSyntax},
+	{Input: "P-1D", Err: ErrSyntax},
+}
+
+func TestDuration(t *testing.T) {
+	for idx, test := range durationTests {
+		got, err := Duration(test.Input).Normalize()
+		if test.Err != nil {
+			if !errors.Is(err, test.Err) {
+				t.Errorf("#%d: Normalize(%q): have error %v, want %v", idx, test.Input, err, test.Err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("#%d: Normalize(%q): unexpected error: %s", idx, test.Input, err)
+		} else if string(got) != test.Canonical {
+			t.Errorf("#%d: Normalize(%q): have %q, want %q", idx, test.Input, got, test.Canonical)
+		}
+	}
+}
+
+func TestDurationArithmetic(t *testing.T) {
+	tests := []struct {
+		Start    DateTime
+		Duration Duration
+		Expect   DateTime
+	}{
+		{"2000-01-12T12:13:14Z", "P1Y3M5DT7H10M3.3S", "2001-04-17T19:23:17.3Z"},
+		{"2000-01-31T00:00:00", "P1M", "2000-02-29T00:00:00"},
+		{"2000-03-31T00:00:00+02:00", "-P1M", "2000-02-29T00:00:00+02:00"},
+		{"2000-01-01T00:00:00", "-PT1S", "1999-12-31T23:59:59"},
+	}
+	for idx, test := range tests {
+		got, err := test.Duration.AddTo(test.Start)
+		if err != nil || got != test.Expect {
+			t.Errorf("#%d: %s.AddTo(%s): have %s (%v), want %s", idx, test.Duration, test.Start, got, err, test.Expect)
+		}
+	}
+	if d, err := Duration("-P1DT1.5S").TimeDuration(); err != nil || d != -(24*time.Hour+1500*time.Millisecond) {
+		t.Errorf("TimeDuration: have %v (%v)", d, err)
+	}
+	if _, err := Duration("P1M").TimeDuration(); err != ErrInexact {
+		t.Errorf("TimeDuration(P1M): have %v, want ErrInexact", err)
+	}
+	if got := DurationValueOf(-90 * time.Minute).String(); got != "-PT1H30M" {
+		t.Errorf("DurationValueOf: have %q", got)
+	}
+}
+
+func TestBigNumbers(t *testing.T) {
+	var i, ten Integer
+	if err := i.Parse("123456789012345678901234567890"); err != nil {
+		t.Fatal(err)
+	}
+	ten.Set("+000010")
+	if sum := i.Add(ten); sum.String() != "123456789012345678901234567900" {
+		t.Errorf("Integer.Add: have %q", sum)
+	}
+	if c := NewInteger(-5).Cmp(NewInteger(3)); c != -1 {
+		t.Errorf("Integer.Cmp: have %d", c)
+	}
+	if n, ok := ten.N(); ten.String() != "10" || n != 10 || !ok {
+		t.Errorf("Integer.String: have %q", ten)
+	}
+	if n, ok := i.N(); n != 0 || ok {
+		t.Errorf("Integer.N: have %d, %v for a value beyond int64", n, ok)
+	}
+	if n, ok := (Integer{}).N(); n != 0 || ok {
+		t.Errorf("Integer.N: have %d, %v for an unset value", n, ok)
+	}
+	if err := i.Parse("12x"); !errors.Is(err, ErrSyntax) || i.IsZero() {
+		t.Errorf("Integer.Parse: have %v, want ErrSyntax and unchanged value", err)
+	}
+	if i.Set("12x"); !i.IsZero() || i.String() != "" {
+		t.Errorf("Integer.Set: have %q, want no value", i)
+	}
+	if err := i.UnmarshalText([]byte("1.5")); !errors.Is(err, ErrSyntax) {
+		t.Errorf("Integer.UnmarshalText: have %v, want ErrSyntax", err)
+	}
+
+	decimal := func(s string) Decimal {
+		var d Decimal
+		if err := d.Parse(s); err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	canonical := []struct{ In, Out string }{
+		{"+01.50", "1.5"},
+		{"-.250", "-0.25"},
+		{"3.000", "3"},
+		{"-0.0", "0"},
+		{"12345678901234567890.000000000000000000001", "12345678901234567890.000000000000000000001"},
+	}
+	for idx, test := range canonical {
+		if got := decimal(test.In).String(); got != test.Out {
+			t.Errorf("#%d: Decimal(%q).String: have %q, want %q", idx, test.In, got, test.Out)
+		}
+	}
+	if got := decimal("0.1").Add(decimal("0.2")); got.String() != "0.3" {
+		t.Errorf("Decimal.Add: have %q", got)
+	}
+	if got := decimal("2.5").Mul(decimal("-0.04")); got.String() != "-0.1" {
+		t.Errorf("Decimal.Mul: have %q", got)
+	}
+	if c := decimal("1.10").Cmp(decimal("1.1")); c != 0 {
+		t.Errorf("Decimal.Cmp: have %d", c)
+	}
+	if got := NewInteger(7).Decimal().Sub(decimal("0.5")); got.String() != "6.5" {
+		t.Errorf("Decimal.Sub: have %q", got)
+	}
+	var d Decimal
+	if err := d.UnmarshalText([]byte("1e3")); !errors.Is(err, ErrSyntax) || !d.IsZero() {
+		t.Errorf("Decimal.UnmarshalText: have %q (%v), want ErrSyntax", d, err)
+	}
+	if _, err := DecimalOf(big.NewRat(1, 3)); err != ErrNotDecimal {
+		t.Errorf("DecimalOf(1/3): have %v, want ErrNotDecimal", err)
+	}
+	if attr, err := d.MarshalXMLAttr(xml.Name{Local: "x"}); err != nil || attr.Name.Local != "" {
+		t.Errorf("Decimal.MarshalXMLAttr: have %v (%v), want no attribute", attr, err)
+	}
+}
+
+func TestBinary(t *testing.T) {
+	var b Base64Binary
+	if err := b.UnmarshalText([]byte(" aGVs\n bG8=\t")); err != nil || b != "aGVsbG8=" {
+		t.Errorf("Base64Binary.UnmarshalText: have %q (%v)", b, err)
+	}
+	if data, err := b.Bytes(); err != nil || string(data) != "hello" {
+		t.Errorf("Base64Binary.Bytes: have %q (%v)", data, err)
+	}
+	if err := b.Parse("aGVsbG8"); !errors.Is(err, ErrSyntax) || b != "aGVsbG8=" {
+		t.Errorf("Base64Binary.Parse: have %q (%v), want ErrSyntax and unchanged value", b, err)
+	}
+	var h HexBinary
+	h.SetBytes([]byte{0x0f, 0xb7})
+	if h != "0FB7" {
+		t.Errorf("HexBinary.SetBytes: have %q", h)
+	}
+	if err := h.Parse("0FB"); !errors.Is(err, ErrSyntax) {
+		t.Errorf("HexBinary.Parse: have %v, want ErrSyntax", err)
+	}
+}
+
+func TestBinaryElementStreaming(t *testing.T) {
+	payload := bytes.Repeat([]byte("0123456789"), 5000)
+	var doc bytes.Buffer
+	enc := xml.NewEncoder(&doc)
+	if _, err := EncodeBase64Element(enc, xml.StartElement{Name: xml.Name{Local: "binData"}}, bytes.NewReader(payload)); err != nil {
+		t.Fatalf("EncodeBase64Element: %s", err)
+	}
+
+	//	Re-wrap the encoded text across lines, as real documents do.
+	text := strings.TrimSuffix(strings.TrimPrefix(doc.String(), "<binData>"), "</binData>")
+	var wrapped strings.Builder
+	for len(text) > 76 {
+		wrapped.WriteString(text[:76] + "\n")
+		text = text[76:]
+	}
+	wrapped.WriteString(text)
+
+	dec := xml.NewDecoder(strings.NewReader("<FContent><binData>" + wrapped.String() + "</binData><next/></FContent>"))
+	dec.Token()
+	dec.Token()
+	var out bytes.Buffer
+	if n, err := DecodeBase64Element(dec, &out); err != nil || n != int64(len(payload)) || !bytes.Equal(out.Bytes(), payload) {
+		t.Fatalf("DecodeBase64Element: decoded %d bytes (%v)", n, err)
+	}
+	if tok, _ := dec.Token(); tok.(xml.StartElement).Name.Local != "next" {
+		t.Errorf("DecodeBase64Element did not stop at the end tag, next token is %#v", tok)
+	}
+}
+
+type marshalRecord struct {
+	XMLName xml.Name           `xml:"rec"`
+	Flag    Boolean            `xml:"flag,attr"`
+	Count   NonNegativeInteger `xml:"count,attr,omitempty"`
+	Refs    Idrefs             `xml:"refs,attr,omitempty"`
+	Tokens  NmtokenList        `xml:"tokens,attr,omitempty"`
+	Created DateTime           `xml:"created"`
+	Size    Integer            `xml:"size"`
+}
+
+func TestXMLMarshalers(t *testing.T) {
+	var rec marshalRecord
+	in := `<rec flag="1" count="+7" refs=" a  b&#10;c " tokens="x&#9;1-2  y"><created>2002-10-10T12:00:00Z</created><size>123456789012345678901234567890</size></rec>`
+	if err := xml.Unmarshal([]byte(in), &rec); err != nil {
+		t.Fatalf("Unmarshal: %s", err)
+	}
+	if !rec.Flag.B() || rec.Count != 7 || rec.Refs != "a b c" || len(rec.Tokens) != 3 || rec.Tokens[1] != "1-2" || rec.Size.String() != "123456789012345678901234567890" {
+		t.Errorf("Unmarshal: have %#v", rec)
+	}
+	out, err := xml.Marshal(&rec)
+	if want := `<rec flag="true" count="7" refs="a b c" tokens="x 1-2 y"><created>2002-10-10T12:00:00Z</created><size>123456789012345678901234567890</size></rec>`; err != nil || string(out) != want {
+		t.Errorf("Marshal:\nhave %s (%v)\nwant %s", out, err, want)
+	}
+
+	bad := []string{
+		`<rec flag="yes"/>`,
+		`<rec flag="true" count="-1"/>`,
+		`<rec flag="true"><created>2002-10-10 12:00</created></rec>`,
+		`<rec flag="true"><size>1.5</size></rec>`,
+	}
+	for idx, doc := range bad {
+		if err := xml.Unmarshal([]byte(doc), new(marshalRecord)); err == nil {
+			t.Errorf("#%d: Unmarshal(%q): expected an error", idx, doc)
+		}
+	}
+}
+
+func TestWhiteSpace(t *testing.T) {
+	in := "\t Botticelli,\r\n  Sandro \u00a0"
+	if got := ReplaceWhiteSpace(in); got != "  Botticelli,    Sandro \u00a0" {
+		t.Errorf("ReplaceWhiteSpace: have %q", got)
+	}
+	if got := CollapseWhiteSpace(in); got != "Botticelli, Sandro \u00a0" {
+		t.Errorf("CollapseWhiteSpace: have %q", got)
+	}
+	var tok Token
+	tok.Set(" a\tb ")
+	var ns NormalizedString
+	ns.Set(" a\tb ")
+	var s String
+	s.Set(" a\tb ")
+	if tok != "a b" || ns != " a b " || s != " a\tb " {
+		t.Errorf("Set: have token %q, normalizedString %q, string %q", tok, ns, s)
+	}
+	var lang struct {
+		Lang Language `xml:"lang,attr"`
+		ID   NCName   `xml:"id"`
+	}
+	if err := xml.Unmarshal([]byte("<x lang=' de '><id>\n\tobj-1\n</id></x>"), &lang); err != nil || lang.Lang != "de" || lang.ID != "obj-1" {
+		t.Errorf("Unmarshal: have %#v (%v)", lang, err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	type validator interface{ Validate() error }
+	tests := []struct {
+		v    validator
+		rule string
+	}{
+		{Name("lido:record"), ""},
+		{Name("1abc"), "NameStartChar"},
+		{NCName("lido:record"), "NCName (no colons)"},
+		{NCName("obj-1.a"), ""},
+		{NCName(""), "non-empty value"},
+		{Id("é_1"), ""},
+		{Id("a b"), "NameChar"},
+		{Nmtoken("1-2"), ""},
+		{Qname("lido:lido"), ""},
+		{Qname("lido:1"), "local part NameStartChar"},
+		{Qname(":x"), "prefix non-empty value"},
+		{Language("de"), ""},
+		{Language("zh-Latn-pinyin-x-hanyu"), ""},
+		{Language("i-klingon"), ""},
+		{Language("en-GB-u-ca-gregory"), ""},
+		{Language("english"), "BCP 47 primary language subtag (2 or 3 letters)"},
+		{Language("en_GB"), "BCP 47 subtag syntax (1 to 8 letters or digits)"},
+		{Language("de-CH-DE"), "BCP 47 region subtag"},
+		{Language("de-1901-1901"), "BCP 47 variant subtag (duplicate)"},
+		{Language("x"), "BCP 47 private use subtag"},
+		{AnyURI("http://vocab.getty.edu/aat/300033618"), ""},
+		{AnyURI("urn:isbn:0451450523"), ""},
+		{AnyURI("../images/a%20b.jpg#top"), ""},
+		{AnyURI("http://[::1]:8080/"), ""},
+		{AnyURI("http://example.org/a b"), "RFC 3986 character set"},
+		{AnyURI("a%2g"), "RFC 3986 percent-encoding"},
+		{AnyURI("1http://x"), "RFC 3986 scheme"},
+		{AnyURI("http://host:80a/"), "RFC 3986 port"},
+		{AnyURI("a#b#c"), "RFC 3986 fragment"},
+	}
+	for idx, test := range tests {
+		err := test.v.Validate()
+		var verr *ValidationError
+		switch {
+		case test.rule == "" && err != nil:
+			t.Errorf("#%d: %#v: unexpected error %v", idx, test.v, err)
+		case test.rule != "" && (!errors.As(err, &verr) || verr.Rule != test.rule):
+			t.Errorf("#%d: %#v: expected rule %q, have %v", idx, test.v, test.rule, err)
+		}
+	}
+
+	name, err := Qname("lido:lido").Resolve(map[string]string{"lido": "http://www.lido-schema.org"})
+	if err != nil || name.Space != "http://www.lido-schema.org" || name.Local != "lido" {
+		t.Errorf("Resolve: have %v (%v)", name, err)
+	}
+	if name, err = Qname("xml:lang").Resolve(nil); err != nil || name.Space != "http://www.w3.org/XML/1998/namespace" {
+		t.Errorf("Resolve: have %v (%v)", name, err)
+	}
+	if name, err = Qname("title").Resolve(map[string]string{"": "urn:x"}); err != nil || name.Space != "urn:x" {
+		t.Errorf("Resolve: have %v (%v)", name, err)
+	}
+	if _, err = Qname("mets:file").Resolve(nil); err == nil {
+		t.Errorf("Resolve: expected an error for an undeclared prefix")
+	}
+}
+
+func TestList(t *testing.T) {
+	refs := Idrefs(" AMD1  AMD2 ").List()
+	if len(refs) != 2 || refs[1] != "AMD2" {
+		t.Fatalf("Idrefs.List: have %q", refs)
+	}
+	refs.Append("AMD3", "AMD1")
+	refs.AppendUnique("AMD2", "AMD4")
+	if got := refs.String(); got != "AMD1 AMD2 AMD3 AMD1 AMD4" {
+		t.Errorf("Append: have %q", got)
+	}
+	if !refs.Remove("AMD1") || refs.Remove("AMD9") || refs.Contains("AMD1") || refs.Index("AMD4") != 2 {
+		t.Errorf("Remove: have %q", refs)
+	}
+	if err := refs.Validate(); err != nil {
+		t.Errorf("Validate: %s", err)
+	}
+	refs.Append("1st", "a b")
+	if err := refs.Validate(); err == nil || err.(*ValidationError).Rule != "NameStartChar" {
+		t.Errorf("Validate: have %v", err)
+	}
+
+	type color string
+	colors := ListOf[color]("red\tgreen")
+	if len(colors) != 2 || colors.Validate() != nil {
+		t.Errorf("ListOf: have %q", colors)
+	}
+	if colors = append(colors, "dark blue"); colors.Validate() == nil {
+		t.Errorf("Validate: item with whitespace accepted")
+	}
+	var empty List[color]
+	if empty.Set("  "); empty != nil || empty.String() != "" {
+		t.Errorf("Set: have %#v", empty)
+	}
+}
+
+func TestFacets(t *testing.T) {
+	code := MustRestriction("code", "token", Pattern(`[A-Z]{3}`, `[0-9]{4}`), MaxLength(4))
+	percent := MustRestriction("percent", "decimal", MinInclusive("0"), MaxInclusive("100"), TotalDigits(5), FractionDigits(2))
+	shortCode, err := code.Restrict("shortCode", Length(3))
+	if err != nil {
+		t.Fatalf("Restrict: %s", err)
+	}
+	tests := []struct {
+		r          *Restriction
+		value      string
+		facet      string
+		constraint string
+	}{
+		{code, " ABC ", "", ""},
+		{code, "1234", "", ""},
+		{code, "AB", "pattern", "[A-Z]{3}|[0-9]{4}"},
+		{shortCode, "ABC", "", ""},
+		{shortCode, "1234", "length", "3"},
+		{percent, "+099.50", "", ""},
+		{percent, "100.0", "", ""},
+		{percent, "100.01", "maxInclusive", "100"},
+		{percent, "-0.5", "minInclusive", "0"},
+		{percent, "1.005", "fractionDigits", "2"},
+		{MustRestriction("amount", "decimal", TotalDigits(3)), "0012.34", "totalDigits", "3"},
+		{MustRestriction("level", "integer", Enumeration("1", "2", "3")), "+02", "", ""},
+		{MustRestriction("level", "integer", Enumeration("1", "2", "3")), "4", "enumeration", "1|2|3"},
+		{MustRestriction("after", "date", MinExclusive("2000-01-01")), "2000-01-02", "", ""},
+		{MustRestriction("after", "date", MinExclusive("2000-01-01")), "2000-01-01", "minExclusive", "2000-01-01"},
+		{MustRestriction("short", "duration", MaxInclusive("P1M")), "P30D", "maxInclusive", "P1M"},
+		{MustRestriction("short", "duration", MaxInclusive("P1M")), "P27D", "", ""},
+		{MustRestriction("small", "double", MaxExclusive("INF")), "NaN", "maxExclusive", "INF"},
+		{MustRestriction("digest", "hexBinary", Length(2)), "0aFF", "", ""},
+		{MustRestriction("refs", "IDREFS", MaxLength(2)), "a b c", "maxLength", "2"},
+	}
+	for idx, test := range tests {
+		err := test.r.Validate(test.value)
+		var ferr *FacetError
+		switch {
+		case test.facet == "" && err != nil:
+			t.Errorf("#%d: %s(%q): unexpected error %v", idx, test.r.Name, test.value, err)
+		case test.facet != "" && (!errors.As(err, &ferr) || ferr.Facet != test.facet || ferr.Constraint != test.constraint || ferr.Type != test.r.Name):
+			t.Errorf("#%d: %s(%q): expected %s facet %s, have %v", idx, test.r.Name, test.value, test.facet, test.constraint, err)
+		}
+	}
+
+	//	A facet shared between restrictions keeps its value as written and works for each of them.
+	shared := MaxInclusive(" 10 ")
+	small := MustRestriction("small", "int", shared)
+	if _, err := NewRestriction("smallDecimal", "decimal", shared); err != nil || shared.String() != " 10 " {
+		t.Errorf("shared facet: have %q (%v)", shared.String(), err)
+	}
+	if small.Validate("11") == nil || small.Validate("10") != nil {
+		t.Errorf("shared facet: wrong bounds")
+	}
+
+	var perr *ParseError
+	if err := percent.Validate("ten"); !errors.As(err, &perr) {
+		t.Errorf("Validate: expected a ParseError for a value not in the base type, have %v", err)
+	}
+	if err := shortCode.Validate("1234"); err == nil || err.Error() != `xsdt: "1234" is not a valid shortCode: violates length facet 3 (length 4)` {
+		t.Errorf("Validate: have %v", err)
+	}
+	for _, bad := range []func() (*Restriction, error){
+		func() (*Restriction, error) { return NewRestriction("x", "nosuchtype") },
+		func() (*Restriction, error) { return NewRestriction("x", "string", MinInclusive("a")) },
+		func() (*Restriction, error) { return NewRestriction("x", "int", MaxLength(3)) },
+		func() (*Restriction, error) { return NewRestriction("x", "double", TotalDigits(3)) },
+		func() (*Restriction, error) { return NewRestriction("x", "int", Enumeration("1", "two")) },
+		func() (*Restriction, error) { return NewRestriction("x", "string", Pattern("[a-")) },
+	} {
+		if _, err := bad(); err == nil {
+			t.Errorf("NewRestriction: expected an error")
+		}
+	}
+}
+
+func TestRegexp(t *testing.T) {
+	tests := []struct {
+		expr  string
+		match []string
+		fail  []string
+	}{
+		{`[A-Z]{3}`, []string{"ABC"}, []string{"ABCD", "xABC", "AB"}},
+		{`^a$`, []string{"^a$"}, []string{"a"}},
+		{`\i\c*`, []string{"lido:record", "_x1", "é.b"}, []string{"1a", "a b", ""}},
+		{`\I\C`, []string{"1 "}, []string{"a1"}},
+		{`[a-z-[aeiou]]+`, []string{"bcd"}, []string{"bad", "B"}},
+		{`[^a-z-[x]]`, []string{"A"}, []string{"b", "x"}},
+		{`\p{IsBasicLatin}+`, []string{"Hello!"}, []string{"Héllo"}},
+		{`\p{Lu}\p{Ll}*`, []string{"Ωmega", "Paris"}, []string{"paris"}},
+		{`\P{L}`, []string{"1"}, []string{"a"}},
+		{`\d{4}-\d{2}`, []string{"2024-01", "٢٠٢٤-٠١"}, []string{"2024-1"}},
+		{`\w+`, []string{"abc1"}, []string{"a_b", "a-b", "a b"}},
+		{`[\s\-+]*`, []string{" -+\t"}, []string{"x"}},
+		{`(ab|cd)?e.`, []string{"abex", "ex"}, []string{"abe\n", "abcde"}},
+		{`[-a]`, []string{"-", "a"}, []string{"b"}},
+		{`other:\w{2,}`, []string{"other:reason"}, []string{"other:x", "inapplicable"}},
+		{`\p{Cn}`, []string{"\U000EFFFD"}, []string{"a"}},
+		{`[a-[a]]?b`, []string{"b"}, []string{"ab"}},
+	}
+	for _, test := range tests {
+		re, err := CompileRegexp(test.expr)
+		if err != nil {
+			t.Errorf("CompileRegexp(%q): %s", test.expr, err)
+			continue
+		}
+		for _, s := range test.match {
+			if !re.MatchString(s) {
+				t.Errorf("%q does not match %q (Go syntax %s)", test.expr, s, re.GoRegexp())
+			}
+		}
+		for _, s := range test.fail {
+			if re.MatchString(s) {
+				t.Errorf("%q matches %q (Go syntax %s)", test.expr, s, re.GoRegexp())
+			}
+		}
+	}
+
+	bad := []struct {
+		expr   string
+		offset int
+		msg    string
+	}{
+		{`[abc`, 4, "missing ]"},
+		{`a**`, 2, "quantifier follows quantifier (XSD has no lazy or possessive quantifiers)"},
+		{`a{3,1}`, 1, "quantifier {3,1} has maximum less than minimum"},
+		{`\bword`, 0, "anchor \\b is not supported; XSD patterns always match the whole value"},
+		{`(a)\1`, 3, "back-references are not supported in XSD patterns"},
+		{`\p{IsKlingon}`, 0, "unknown Unicode category or block \\p{IsKlingon}"},
+		{`[z-a]`, 1, "range 'z'-'a' is out of order"},
+		{`[a-\d]`, 3, "\\d cannot be a range endpoint"},
+		{`[a[b]]`, 2, "unescaped [ in character class (subtraction is written [a-z-[aeiou]])"},
+		{`(?i)a`, 1, "(? groups are not supported; XSD groups are plain parentheses"},
+		{`a)`, 1, "unmatched )"},
+		{`*a`, 0, "quantifier * without preceding atom"},
+		{`\u0041`, 0, "\\u escapes are not supported; use an &#x...; character reference in the schema"},
+	}
+	for _, test := range bad {
+		_, err := CompileRegexp(test.expr)
+		var rerr *RegexpError
+		if !errors.As(err, &rerr) || rerr.Offset != test.offset || rerr.Msg != test.msg {
+			t.Errorf("CompileRegexp(%q): expected %q at offset %d, have %v", test.expr, test.msg, test.offset, err)
+		}
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	pos, err := LookupQName("xs:positiveInteger", nil)
+	if err != nil || pos.GoType != reflect.TypeOf(PositiveInteger(0)) || pos.Primitive.Name != "decimal" || pos.Variety != VarietyAtomic {
+		t.Fatalf("LookupQName: have %+v (%v)", pos, err)
+	}
+	integer, _ := LookupQName("s:integer", map[string]string{"s": XsdNamespace})
+	if !pos.DerivesFrom(integer) || integer.DerivesFrom(pos) || !pos.DerivesFrom(pos) {
+		t.Errorf("DerivesFrom: wrong for %s and %s", pos, integer)
+	}
+	if _, err := LookupQName("xs:nonsense", nil); err == nil {
+		t.Errorf("LookupQName: expected an error for an unknown type")
+	}
+	if _, err := LookupQName("lido:text", nil); err == nil {
+		t.Errorf("LookupQName: expected an error for an undeclared prefix")
+	}
+
+	var rec struct {
+		Refs  IdrefList
+		Size  *Long
+		Title String
+		Other string
+	}
+	rt := reflect.TypeOf(rec)
+	for i, want := range []string{"IDREFS", "long", "string", ""} {
+		got := TypeOf(rt.Field(i).Type)
+		if (got == nil && want != "") || (got != nil && got.Name != want) {
+			t.Errorf("TypeOf(%s): have %v, want %s", rt.Field(i).Type, got, want)
+		}
+	}
+	refs := TypeOf(reflect.TypeOf(Idrefs("")))
+	if refs.Variety != VarietyList || refs.ItemType.Name != "IDREF" || refs.Primitive != nil || refs.ListGoType != reflect.TypeOf(IdrefList(nil)) {
+		t.Errorf("IDREFS: have %+v", refs)
+	}
+	if err := refs.Validate(" a  b "); err != nil {
+		t.Errorf("IDREFS.Validate: %s", err)
+	}
+
+	for _, typ := range BuiltinTypes() {
+		if typ.Base == nil && typ.Name != "anyType" {
+			t.Errorf("%s has no base type", typ)
+		}
+		if typ.Variety != VarietyAbsent && typ.Name != "string" && typ.Name != "normalizedString" && typ.Name != "token" {
+			if b, ok := builtinBases[typ.Name]; !ok || b.ws != typ.WhiteSpace || b.check == nil {
+				t.Errorf("%s is not known to the facet engine", typ)
+			}
+		}
+		if typ.Base != nil && typ.Base.Variety != VarietyAbsent {
+			if _, err := NewRestriction("test", typ.Base.Name, typ.Facets...); err != nil {
+				t.Errorf("%s: facets do not apply to base %s: %s", typ, typ.Base, err)
+			}
+		}
+	}
+}